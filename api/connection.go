@@ -1,27 +1,20 @@
 package api
 
-import (
-	"encoding/json"
-	"fmt"
-)
-
-type ConnectionResourceID struct {
-	ConnecctionID string `json:"connectionId"`
-}
+import "context"
 
 type ConnectionResource struct {
-	Name          string `json:"name"`
-	SourceID      string `json:"sourceId,omitempty"`
-	DestinationID string `json:"destinationId,omitempty"`
-	ConnectionID  string `json:"connectionId,omitempty"`
-	// SyncCatalog                      interface{}      `json:"syncCatalog"`
-	DataResidency                    string           `json:"dataResidency,omitempty"`
-	NamespaceDefinition              string           `json:"namespaceDefinition,omitempty"`
-	NamespaceFormat                  string           `json:"namespaceFormat,omitempty"`
-	NonBreakingSchemaUpdatesBehavior string           `json:"nonBreakingSchemaUpdatesBehavior,omitempty"`
-	Prefix                           string           `json:"prefix,omitempty"`
-	Status                           string           `json:"status,omitempty"`
-	Schedule                         ConnScheduleData `json:"schedule"`
+	Name                             string             `json:"name"`
+	SourceID                         string             `json:"sourceId,omitempty"`
+	DestinationID                    string             `json:"destinationId,omitempty"`
+	ConnectionID                     string             `json:"connectionId,omitempty"`
+	DataResidency                    string             `json:"dataResidency,omitempty"`
+	NamespaceDefinition              string             `json:"namespaceDefinition,omitempty"`
+	NamespaceFormat                  string             `json:"namespaceFormat,omitempty"`
+	NonBreakingSchemaUpdatesBehavior string             `json:"nonBreakingSchemaUpdatesBehavior,omitempty"`
+	Prefix                           string             `json:"prefix,omitempty"`
+	Status                           string             `json:"status,omitempty"`
+	Schedule                         ConnScheduleData   `json:"schedule"`
+	Configurations                   ConnConfigurations `json:"configurations"`
 	//OperatorConfiguration connOperatorConfig `json:"operator_configuration"`
 }
 type ConnScheduleData struct {
@@ -29,89 +22,43 @@ type ConnScheduleData struct {
 	CronExpression string `json:"cronExpression,omitempty"`
 }
 
-type DiscoverSourceSchemaCatalog struct {
-	SourceID     string `json:"sourceId"`
-	DisableCache bool   `json:"disable_cache"`
+// ConnectionPatch carries only the connection attributes to be changed.
+// Nil fields are left out of the request and retain their current value.
+type ConnectionPatch struct {
+	Name                             *string             `json:"name,omitempty"`
+	DataResidency                    *string             `json:"dataResidency,omitempty"`
+	NamespaceDefinition              *string             `json:"namespaceDefinition,omitempty"`
+	NamespaceFormat                  *string             `json:"namespaceFormat,omitempty"`
+	NonBreakingSchemaUpdatesBehavior *string             `json:"nonBreakingSchemaUpdatesBehavior,omitempty"`
+	Prefix                           *string             `json:"prefix,omitempty"`
+	Status                           *string             `json:"status,omitempty"`
+	Schedule                         *ConnScheduleData   `json:"schedule,omitempty"`
+	Configurations                   *ConnConfigurations `json:"configurations,omitempty"`
 }
 
-func (c *Client) CreateConnectionResource(payload ConnectionResource) (ConnectionResource, error) {
-	// logger := fwhelpers.GetLogger()
-
-	method := "POST"
-	url := c.Host + "/v1/connections"
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return ConnectionResource{}, err
-	}
-
-	b, statusCode, _, _, err := c.doRequest(method, url, body, nil)
-	if err != nil {
-		return ConnectionResource{}, err
-	}
-
-	connection := ConnectionResource{}
-	if statusCode >= 200 && statusCode <= 299 {
-		err = json.Unmarshal(b, &connection)
-		return connection, err
-	} else {
-		msg, err := c.getAPIError(b)
-		if err != nil {
-			return connection, err
-		} else {
-			return connection, fmt.Errorf(msg)
-		}
-	}
+type ConnConfigurations struct {
+	Streams []ConnStreamConfiguration `json:"streams,omitempty"`
 }
 
-func (c *Client) ReadConnectionResource(connectionId string) (ConnectionResource, error) {
-	// logger := fwhelpers.GetLogger()
-
-	method := "GET"
-	url := c.Host + "/v1/connections/" + connectionId
-
-	b, statusCode, _, _, err := c.doRequest(method, url, []byte{}, nil)
-	if err != nil {
-		return ConnectionResource{}, err
-	}
-	connection := ConnectionResource{}
-	if statusCode >= 200 && statusCode <= 299 {
-		err = json.Unmarshal(b, &connection)
-		return connection, err
-	} else {
-		msg, err := c.getAPIError(b)
-		if err != nil {
-			return connection, err
-		} else {
-			return connection, fmt.Errorf(msg)
-		}
-	}
+type ConnStreamConfiguration struct {
+	Name        string     `json:"name"`
+	SyncMode    string     `json:"syncMode,omitempty"`
+	CursorField []string   `json:"cursorField,omitempty"`
+	PrimaryKey  [][]string `json:"primaryKey,omitempty"`
 }
 
-func (c *Client) UpdateConnectionResource(payload ConnectionResource) (ConnectionResource, error) {
-	// logger := fwhelpers.GetLogger()
-
-	return ConnectionResource{}, fmt.Errorf("update resource is not supported")
+func (c *Client) CreateConnectionResource(ctx context.Context, payload ConnectionResource) (ConnectionResource, error) {
+	return NewResource[ConnectionResource](c, connectionsPath).Create(ctx, payload)
 }
 
-func (c *Client) DeleteConnectionResource(connectionId string) error {
-	// logger := fwhelpers.GetLogger()
-
-	method := "DELETE"
-	url := c.Host + "/v1/connections/" + connectionId
+func (c *Client) ReadConnectionResource(ctx context.Context, connectionId string) (ConnectionResource, error) {
+	return NewResource[ConnectionResource](c, connectionsPath).Read(ctx, connectionId)
+}
 
-	b, statusCode, _, _, err := c.doRequest(method, url, []byte{}, nil)
-	if err != nil {
-		return err
-	}
+func (c *Client) UpdateConnectionResource(ctx context.Context, connectionId string, payload ConnectionPatch) (ConnectionResource, error) {
+	return NewResource[ConnectionResource](c, connectionsPath).Update(ctx, connectionId, payload)
+}
 
-	if statusCode >= 200 && statusCode <= 299 {
-		return nil
-	} else {
-		msg, err := c.getAPIError(b)
-		if err != nil {
-			return err
-		} else {
-			return fmt.Errorf(msg)
-		}
-	}
+func (c *Client) DeleteConnectionResource(ctx context.Context, connectionId string) error {
+	return NewResource[ConnectionResource](c, connectionsPath).Delete(ctx, connectionId)
 }
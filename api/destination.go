@@ -0,0 +1,31 @@
+package api
+
+import "context"
+
+// Destination of any type, configured as described by the
+// connectionSpecification of its connector.
+type Destination struct {
+	Name            string         `json:"name"`
+	DestinationId   string         `json:"destinationId,omitempty"`
+	WorkspaceId     string         `json:"workspaceId"`
+	DestinationType string         `json:"destinationType,omitempty"`
+	Configuration   map[string]any `json:"configuration"`
+}
+
+func (c *Client) CreateDestination(ctx context.Context, payload Destination) (Destination, error) {
+	return NewResource[Destination](c, destinationsPath).Create(ctx, payload)
+}
+
+func (c *Client) ReadDestination(ctx context.Context, destinationId string) (Destination, error) {
+	return NewResource[Destination](c, destinationsPath).Read(ctx, destinationId)
+}
+
+func (c *Client) UpdateDestination(ctx context.Context, payload Destination) (Destination, error) {
+	destinationId := payload.DestinationId
+	payload.DestinationId = ""
+	return NewResource[Destination](c, destinationsPath).Update(ctx, destinationId, payload)
+}
+
+func (c *Client) DeleteDestination(ctx context.Context, destinationId string) error {
+	return NewResource[Destination](c, destinationsPath).Delete(ctx, destinationId)
+}
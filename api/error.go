@@ -2,11 +2,16 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
 type APIError struct {
+	Type               string                    `json:"type"`
+	Title              string                    `json:"title"`
+	Detail             string                    `json:"detail"`
 	Message            string                    `json:"message"`
 	ExceptionClassName string                    `json:"exceptionClassName"`
 	ExceptionStack     []string                  `json:"exceptionStack"`
@@ -19,13 +24,69 @@ type APIErrorValidationError struct {
 	Message      string `json:"message"`
 }
 
-func (c *Client) getAPIError(body []byte) (string, error) {
+// Error is returned for all the unsuccessful responses from Airbyte API.
+type Error struct {
+	StatusCode int
+	Method     string
+	URL        string
+
+	// Problem details from the response body.
+	Type             string
+	Title            string
+	Detail           string
+	ValidationErrors []APIErrorValidationError
+}
+
+func (e *Error) Error() string {
+	msg := e.Detail
+	if msg == "" {
+		msg = e.Title
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+
+	lines := []string{msg}
+	for _, v := range e.ValidationErrors {
+		lines = append(lines, fmt.Sprintf("%s: %s", v.Field(), v.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Field returns the attribute path of the invalid value,
+// e.g. configuration.start_date.
+func (v APIErrorValidationError) Field() string {
+	return strings.TrimPrefix(v.PropertyPath, "$.")
+}
+
+// IsNotFound reports whether err is an API error for a missing resource.
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+func (c *Client) getAPIError(method string, url string, statusCode int, body []byte) error {
+	e := &Error{
+		StatusCode: statusCode,
+		Method:     method,
+		URL:        url,
+	}
+
 	apiErr := APIError{}
 	err := json.Unmarshal(body, &apiErr)
 	if err != nil {
-		return "", fmt.Errorf("content type mismatch or invalid provider api host or path")
-	} else {
+		e.Detail = "content type mismatch or invalid provider api host or path"
+		return e
+	}
+
+	e.Type = apiErr.Type
+	e.Title = apiErr.Title
+	e.Detail = apiErr.Detail
+	if e.Detail == "" {
 		slices := strings.Split(apiErr.Message, "at [Source:")
-		return strings.TrimSpace(slices[0]), nil
+		e.Detail = strings.TrimSpace(slices[0])
 	}
+	e.ValidationErrors = apiErr.ValidationErrors
+
+	return e
 }
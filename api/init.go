@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,60 +14,165 @@ type Client struct {
 	HTTPClient    *http.Client
 	Host          string
 	Authorization string
+	MaxRetries    int
+	RetryMaxWait  time.Duration
+	ClientID      string
+	ClientSecret  string
+	rateLimiter   *rateLimiter
+
+	// RequestTimeout bounds each request attempt unless the
+	// context passed by the caller already has a deadline.
+	RequestTimeout time.Duration
+
+	// CreateTimeout and DiscoverTimeout bound the whole create and
+	// stream discovery operations, which may run connection checks
+	// on the Airbyte side. Zero falls back to RequestTimeout.
+	CreateTimeout   time.Duration
+	DiscoverTimeout time.Duration
 }
 
+// Used when no request timeout is configured.
+const DefaultRequestTimeout = time.Duration(10) * time.Second
+
 func NewClient(host string, authorization string) (*Client, error) {
 	c := Client{
-		HTTPClient: &http.Client{
-			Timeout: time.Duration(10) * time.Second,
-		},
-		Host:          host,
-		Authorization: authorization,
+		HTTPClient:     &http.Client{},
+		Host:           host,
+		Authorization:  authorization,
+		MaxRetries:     DefaultMaxRetries,
+		RetryMaxWait:   DefaultRetryMaxWait,
+		RequestTimeout: DefaultRequestTimeout,
 	}
 	return &c, nil
 }
 
-func (c *Client) doRequest(method string, url string, body []byte, headers map[string]string) ([]byte, int, string, map[string][]string, error) {
-	payload := bytes.NewBuffer(body)
-
-	req, err := http.NewRequest(method, url, payload)
-	if err != nil {
-		return nil, 500, "500 Internal Server Error", nil, err
+// SetRateLimit configures the limiter shared by all the clients with
+// the same host and credentials. A non-positive rate disables it.
+func (c *Client) SetRateLimit(requestsPerSecond float64, burst int) {
+	if burst < 1 {
+		burst = 1
+	}
+	credential := c.Authorization
+	if c.ClientID != "" {
+		credential = c.ClientID
 	}
+	c.rateLimiter = sharedRateLimiter(c.Host, credential, requestsPerSecond, burst)
+}
 
-	if c.Authorization != "" {
-		c.Authorization = c.getBearerToken()
+// Helper function to bound an operation by the given timeout,
+// leaving the context unchanged if the timeout is not set.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
 	}
+	return context.WithTimeout(ctx, timeout)
+}
 
-	req.Header.Add("Authorization", c.Authorization)
-	req.Header.Add("Accept", "*/*")
-	req.Header.Add("User-Agent", "PCT")
-	req.Header.Add("Content-Type", "application/json")
+// Helper function to bound a request attempt by RequestTimeout,
+// unless the operation already has a deadline of its own.
+func (c *Client) attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return withTimeout(ctx, c.RequestTimeout)
+}
 
-	for header, value := range headers {
-		req.Header.Add(header, value)
+func (c *Client) doRequest(ctx context.Context, method string, url string, body []byte, headers map[string]string) ([]byte, int, string, map[string][]string, error) {
+	if c.rateLimiter == nil {
+		c.SetRateLimit(DefaultRequestsPerSecond, DefaultBurst)
 	}
 
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, 500, "500 Internal Server Error", nil, err
+	// Token rejected with 401 is refreshed once per request.
+	reauthorized := false
+
+	for attempt := 0; ; attempt++ {
+		authorization, err := c.getBearerToken(ctx)
+		if err != nil {
+			return nil, 500, "500 Internal Server Error", nil, err
+		}
+
+		err = c.rateLimiter.Wait(ctx)
+		if err != nil {
+			return nil, 500, "500 Internal Server Error", nil, err
+		}
+
+		reqCtx, cancel := c.attemptContext(ctx)
+
+		payload := bytes.NewBuffer(body)
+
+		req, err := http.NewRequestWithContext(reqCtx, method, url, payload)
+		if err != nil {
+			cancel()
+			return nil, 500, "500 Internal Server Error", nil, err
+		}
+
+		req.Header.Add("Authorization", authorization)
+		req.Header.Add("Accept", "*/*")
+		req.Header.Add("User-Agent", "PCT")
+		req.Header.Add("Content-Type", "application/json")
+
+		for header, value := range headers {
+			req.Header.Add(header, value)
+		}
+
+		res, err := c.HTTPClient.Do(req)
+		if err != nil {
+			cancel()
+			if ctx.Err() == nil && attempt < c.MaxRetries && isIdempotent(method) {
+				err = sleep(ctx, c.backoff(attempt))
+				if err != nil {
+					return nil, 500, "500 Internal Server Error", nil, err
+				}
+				continue
+			}
+			return nil, 500, "500 Internal Server Error", nil, err
+		}
+		b, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		cancel()
+		if err != nil {
+			return nil, 500, "500 Internal Server Error", nil, err
+		}
+
+		if res.StatusCode == http.StatusUnauthorized && c.ClientID != "" && !reauthorized {
+			c.invalidateAccessToken(strings.TrimPrefix(authorization, "Bearer "))
+			reauthorized = true
+			attempt--
+			continue
+		}
+
+		if attempt < c.MaxRetries && shouldRetry(method, res.StatusCode) {
+			wait, ok := c.retryAfter(res.Header)
+			if !ok {
+				wait = c.backoff(attempt)
+			}
+			err = sleep(ctx, wait)
+			if err != nil {
+				return nil, 500, "500 Internal Server Error", nil, err
+			}
+			continue
+		}
+
+		return b, res.StatusCode, res.Status, res.Header, nil
 	}
-	b, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, 500, "500 Internal Server Error", nil, err
+}
+
+func (c *Client) getBearerToken(ctx context.Context) (string, error) {
+	if c.ClientID != "" {
+		accessToken, err := c.getAccessToken(ctx)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Bearer %s", accessToken), nil
 	}
 
-	defer res.Body.Close()
-	return b, res.StatusCode, res.Status, res.Header, nil
-}
-func (c *Client) getBearerToken() string {
 	if c.Authorization == "" {
-		return ""
+		return "", nil
 	}
 
 	if strings.HasPrefix(c.Authorization, "Bearer") {
-		return c.Authorization
+		return c.Authorization, nil
 
 	}
-	return fmt.Sprintf("Bearer %s", c.Authorization)
+	return fmt.Sprintf("Bearer %s", c.Authorization), nil
 }
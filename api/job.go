@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Job struct {
+	JobId         int    `json:"jobId,omitempty"`
+	ConnectionId  string `json:"connectionId"`
+	JobType       string `json:"jobType"`
+	Status        string `json:"status,omitempty"`
+	StartTime     string `json:"startTime,omitempty"`
+	LastUpdatedAt string `json:"lastUpdatedAt,omitempty"`
+	Duration      string `json:"duration,omitempty"`
+	BytesSynced   int    `json:"bytesSynced,omitempty"`
+	RowsSynced    int    `json:"rowsSynced,omitempty"`
+}
+
+// JobsFilter narrows down the jobs listed for a connection.
+// Empty fields are not filtered on and a zero Limit lists all jobs.
+type JobsFilter struct {
+	ConnectionId   string
+	JobType        string
+	Status         string
+	CreatedAtStart string
+	CreatedAtEnd   string
+	Limit          int
+}
+
+// IsTerminal reports whether the job has finished running.
+// Incomplete jobs are still retried by Airbyte.
+func (j Job) IsTerminal() bool {
+	switch j.Status {
+	case "succeeded", "failed", "cancelled":
+		return true
+	}
+	return false
+}
+
+func (c *Client) CreateJob(ctx context.Context, payload Job) (Job, error) {
+	return NewResource[Job](c, jobsPath).Create(ctx, payload)
+}
+
+func (c *Client) ReadJob(ctx context.Context, jobId int) (Job, error) {
+	return NewResource[Job](c, jobsPath).Read(ctx, strconv.Itoa(jobId))
+}
+
+// ListJobs returns the jobs matching the filter, most recent first,
+// following the pagination of the API.
+func (c *Client) ListJobs(ctx context.Context, filter JobsFilter) ([]Job, error) {
+	query := url.Values{}
+	query.Set("connectionId", filter.ConnectionId)
+	if filter.JobType != "" {
+		query.Set("jobType", filter.JobType)
+	}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
+	}
+	if filter.CreatedAtStart != "" {
+		query.Set("createdAtStart", filter.CreatedAtStart)
+	}
+	if filter.CreatedAtEnd != "" {
+		query.Set("createdAtEnd", filter.CreatedAtEnd)
+	}
+	query.Set("orderBy", "createdAt|DESC")
+
+	return NewResource[Job](c, jobsPath).List(ctx, query, filter.Limit)
+}
+
+// WaitForJob polls the job until it reaches a terminal status
+// or the timeout expires, returning early if the context is done.
+func (c *Client) WaitForJob(ctx context.Context, jobId int, interval time.Duration, timeout time.Duration) (Job, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		job, err := c.ReadJob(ctx, jobId)
+		if err != nil {
+			return job, err
+		}
+		if job.IsTerminal() {
+			return job, nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return job, fmt.Errorf("timed out waiting for job %d, last status %s", jobId, job.Status)
+		}
+		err = sleep(ctx, interval)
+		if err != nil {
+			return job, err
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Access tokens are refreshed this long before they expire.
+const tokenExpiryMargin = time.Duration(60) * time.Second
+
+type AccessTokenRequest struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	GrantType    string `json:"grant-type"`
+}
+
+type AccessTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// Access token cache for a client credentials pair.
+type tokenSource struct {
+	mu          sync.Mutex
+	accessToken string
+	expiry      time.Time
+}
+
+// Token sources shared by all the clients of a host and client ID
+// so that a token is exchanged once for the whole process.
+var (
+	tokenSourcesMu sync.Mutex
+	tokenSources   = map[string]*tokenSource{}
+)
+
+func sharedTokenSource(host string, clientID string) *tokenSource {
+	tokenSourcesMu.Lock()
+	defer tokenSourcesMu.Unlock()
+
+	key := host + "\x00" + clientID
+	ts, ok := tokenSources[key]
+	if !ok {
+		ts = &tokenSource{}
+		tokenSources[key] = ts
+	}
+	return ts
+}
+
+// Returns the cached access token, exchanging the client
+// credentials for a new one if it is missing or about to expire.
+func (c *Client) getAccessToken(ctx context.Context) (string, error) {
+	ts := sharedTokenSource(c.Host, c.ClientID)
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.accessToken != "" && time.Now().Add(tokenExpiryMargin).Before(ts.expiry) {
+		return ts.accessToken, nil
+	}
+
+	token, err := c.requestAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	ts.accessToken = token.AccessToken
+	ts.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	return ts.accessToken, nil
+}
+
+// Drops the cached access token after it was rejected by the API.
+func (c *Client) invalidateAccessToken(accessToken string) {
+	ts := sharedTokenSource(c.Host, c.ClientID)
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.accessToken == accessToken {
+		ts.accessToken = ""
+	}
+}
+
+func (c *Client) requestAccessToken(ctx context.Context) (AccessTokenResponse, error) {
+	method := "POST"
+	url := c.Host + "/v1/applications/token"
+	payload := AccessTokenRequest{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		GrantType:    "client_credentials",
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return AccessTokenResponse{}, err
+	}
+
+	if c.rateLimiter != nil {
+		err = c.rateLimiter.Wait(ctx)
+		if err != nil {
+			return AccessTokenResponse{}, err
+		}
+	}
+
+	ctx, cancel := c.attemptContext(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(body))
+	if err != nil {
+		return AccessTokenResponse{}, err
+	}
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("User-Agent", "PCT")
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return AccessTokenResponse{}, err
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return AccessTokenResponse{}, err
+	}
+
+	token := AccessTokenResponse{}
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		err = json.Unmarshal(b, &token)
+		if err == nil && token.AccessToken == "" {
+			err = fmt.Errorf("no access token received for client credentials")
+		}
+		return token, err
+	} else {
+		return token, fmt.Errorf("unable to obtain access token: %w", c.getAPIError(method, url, res.StatusCode, b))
+	}
+}
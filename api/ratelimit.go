@@ -0,0 +1,88 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultRequestsPerSecond = float64(2)
+	DefaultBurst             = 5
+)
+
+// Token bucket limiter refilled at a fixed rate up to the burst size.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+// Limiters shared by all the clients of a host and authorization pair
+// as Airbyte enforces rate limits per token.
+var (
+	rateLimitersMu sync.Mutex
+	rateLimiters   = map[string]*rateLimiter{}
+)
+
+func sharedRateLimiter(host string, authorization string, rate float64, burst int) *rateLimiter {
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+
+	key := host + "\x00" + authorization
+	l, ok := rateLimiters[key]
+	if !ok {
+		l = &rateLimiter{
+			tokens: float64(burst),
+			last:   time.Now(),
+		}
+		rateLimiters[key] = l
+	}
+	l.setLimit(rate, burst)
+
+	return l
+}
+
+func (l *rateLimiter) setLimit(rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = rate
+	l.burst = burst
+	if l.tokens > float64(burst) {
+		l.tokens = float64(burst)
+	}
+}
+
+// Wait blocks until a token is available or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if l.rate <= 0 {
+			l.mu.Unlock()
+			return nil
+		}
+
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > float64(l.burst) {
+			l.tokens = float64(l.burst)
+		}
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+		err := sleep(ctx, wait)
+		if err != nil {
+			return err
+		}
+	}
+}
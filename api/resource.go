@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Path templates of the API collections, {id} standing for the member ID.
+const (
+	sourcesPath      = "/v1/sources/{id}"
+	destinationsPath = "/v1/destinations/{id}"
+	connectionsPath  = "/v1/connections/{id}"
+	workspacesPath   = "/v1/workspaces/{id}"
+	jobsPath         = "/v1/jobs/{id}"
+)
+
+// Number of members requested per page while listing.
+const listPageSize = 100
+
+// Resource is a typed client for an API collection, addressed
+// by a path template such as /v1/sources/{id}.
+type Resource[T any] struct {
+	client   *Client
+	template string
+}
+
+// Page of a listed collection.
+type listResponse[T any] struct {
+	Data     []T    `json:"data"`
+	Next     string `json:"next"`
+	Previous string `json:"previous"`
+}
+
+// NewResource returns a typed client for the collection of the path template.
+func NewResource[T any](c *Client, template string) *Resource[T] {
+	return &Resource[T]{
+		client:   c,
+		template: template,
+	}
+}
+
+// Create posts a new member to the collection, bounded by CreateTimeout.
+func (r *Resource[T]) Create(ctx context.Context, payload T) (T, error) {
+	ctx, cancel := withTimeout(ctx, r.client.CreateTimeout)
+	defer cancel()
+
+	var member T
+	err := r.client.call(ctx, "POST", r.collectionURL(), payload, &member)
+	return member, err
+}
+
+// Read returns the member with the given ID.
+func (r *Resource[T]) Read(ctx context.Context, id string) (T, error) {
+	var member T
+	err := r.client.call(ctx, "GET", r.memberURL(id), nil, &member)
+	return member, err
+}
+
+// Update patches the member with the given ID. The payload may be a
+// partial representation of T, e.g. with the unchanged fields left out.
+func (r *Resource[T]) Update(ctx context.Context, id string, payload any) (T, error) {
+	var member T
+	err := r.client.call(ctx, "PATCH", r.memberURL(id), payload, &member)
+	return member, err
+}
+
+// Delete removes the member with the given ID.
+func (r *Resource[T]) Delete(ctx context.Context, id string) error {
+	return r.client.call(ctx, "DELETE", r.memberURL(id), nil, nil)
+}
+
+// List returns the members matching the query, following the
+// pagination of the API. A zero limit lists all the members.
+func (r *Resource[T]) List(ctx context.Context, query url.Values, limit int) ([]T, error) {
+	members := []T{}
+	for offset := 0; ; offset += listPageSize {
+		pageSize := listPageSize
+		if limit > 0 && limit-len(members) < pageSize {
+			pageSize = limit - len(members)
+		}
+
+		pageQuery := url.Values{}
+		for key, values := range query {
+			pageQuery[key] = values
+		}
+		pageQuery.Set("limit", strconv.Itoa(pageSize))
+		pageQuery.Set("offset", strconv.Itoa(offset))
+
+		page := listResponse[T]{}
+		err := r.client.call(ctx, "GET", r.collectionURL()+"?"+pageQuery.Encode(), nil, &page)
+		if err != nil {
+			return members, err
+		}
+
+		members = append(members, page.Data...)
+		if page.Next == "" || len(page.Data) < pageSize || (limit > 0 && len(members) >= limit) {
+			return members, nil
+		}
+	}
+}
+
+func (r *Resource[T]) collectionURL() string {
+	return r.client.Host + strings.TrimSuffix(r.template, "/{id}")
+}
+
+func (r *Resource[T]) memberURL(id string) string {
+	return r.client.Host + strings.ReplaceAll(r.template, "{id}", url.PathEscape(id))
+}
+
+// Helper function to send the payload, if any, as JSON and decode
+// the response into out, if given. Non 2xx responses are returned
+// as *Error.
+func (c *Client) call(ctx context.Context, method string, url string, payload any, out any) error {
+	body := []byte{}
+	if payload != nil {
+		var err error
+		body, err = json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	b, statusCode, _, _, err := c.doRequest(ctx, method, url, body, nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode < 200 || statusCode > 299 {
+		return c.getAPIError(method, url, statusCode, b)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
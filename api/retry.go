@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 4
+	DefaultRetryMaxWait = time.Duration(30) * time.Second
+
+	// Backoff for the first retry, doubled on every further attempt.
+	retryMinWait = time.Duration(500) * time.Millisecond
+)
+
+// Requests with these methods can be repeated without side effects.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// Throttled requests and gateway errors are retried only when
+// repeating the request is safe.
+func shouldRetry(method string, statusCode int) bool {
+	if !isIdempotent(method) {
+		return false
+	}
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Exponential backoff with full jitter, capped at RetryMaxWait.
+func (c *Client) backoff(attempt int) time.Duration {
+	wait := c.RetryMaxWait
+	if attempt < 16 && retryMinWait<<attempt < wait {
+		wait = retryMinWait << attempt
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(wait) + 1))
+}
+
+// Wait duration requested by the server through Retry-After header,
+// given either in seconds or as an HTTP date.
+func (c *Client) retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		wait = time.Until(date)
+	} else {
+		return 0, false
+	}
+
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > c.RetryMaxWait {
+		wait = c.RetryMaxWait
+	}
+	return wait, true
+}
+
+// Helper function to sleep for the given duration, returning
+// early with the context error if it is cancelled meanwhile.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		method     string
+		statusCode int
+		want       bool
+	}{
+		{method: http.MethodGet, statusCode: http.StatusTooManyRequests, want: true},
+		{method: http.MethodGet, statusCode: http.StatusBadGateway, want: true},
+		{method: http.MethodGet, statusCode: http.StatusServiceUnavailable, want: true},
+		{method: http.MethodGet, statusCode: http.StatusGatewayTimeout, want: true},
+		{method: http.MethodDelete, statusCode: http.StatusTooManyRequests, want: true},
+		{method: http.MethodPut, statusCode: http.StatusServiceUnavailable, want: true},
+		{method: http.MethodPost, statusCode: http.StatusTooManyRequests, want: false},
+		{method: http.MethodPost, statusCode: http.StatusServiceUnavailable, want: false},
+		{method: http.MethodPatch, statusCode: http.StatusTooManyRequests, want: false},
+		{method: http.MethodGet, statusCode: http.StatusOK, want: false},
+		{method: http.MethodGet, statusCode: http.StatusNotFound, want: false},
+		{method: http.MethodGet, statusCode: http.StatusInternalServerError, want: false},
+	}
+
+	for _, tt := range tests {
+		got := shouldRetry(tt.method, tt.statusCode)
+		if got != tt.want {
+			t.Errorf("shouldRetry(%s, %d) = %t, want %t", tt.method, tt.statusCode, got, tt.want)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	c := &Client{RetryMaxWait: 30 * time.Second}
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{name: "missing", value: ""},
+		{name: "seconds", value: "5", want: 5 * time.Second, wantOk: true},
+		{name: "zero seconds", value: "0", want: 0, wantOk: true},
+		{name: "negative seconds", value: "-5", want: 0, wantOk: true},
+		{name: "capped seconds", value: "120", want: 30 * time.Second, wantOk: true},
+		{name: "past date", value: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), want: 0, wantOk: true},
+		{name: "capped date", value: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), want: 30 * time.Second, wantOk: true},
+		{name: "invalid", value: "soon"},
+		{name: "fractional seconds", value: "1.5"},
+	}
+
+	for _, tt := range tests {
+		header := http.Header{}
+		if tt.value != "" {
+			header.Set("Retry-After", tt.value)
+		}
+		got, ok := c.retryAfter(header)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("%s: retryAfter(%q) = %s, %t, want %s, %t", tt.name, tt.value, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestRetryAfterFutureDate(t *testing.T) {
+	c := &Client{RetryMaxWait: time.Minute}
+
+	header := http.Header{}
+	header.Set("Retry-After", time.Now().Add(20*time.Second).UTC().Format(http.TimeFormat))
+
+	// HTTP dates have a one second resolution.
+	got, ok := c.retryAfter(header)
+	if !ok || got < 18*time.Second || got > 20*time.Second {
+		t.Errorf("retryAfter() = %s, %t, want about 20s, true", got, ok)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt      int
+		retryMaxWait time.Duration
+		wantMax      time.Duration
+	}{
+		{attempt: 0, retryMaxWait: 30 * time.Second, wantMax: retryMinWait},
+		{attempt: 1, retryMaxWait: 30 * time.Second, wantMax: 2 * retryMinWait},
+		{attempt: 3, retryMaxWait: 30 * time.Second, wantMax: 8 * retryMinWait},
+		{attempt: 10, retryMaxWait: 30 * time.Second, wantMax: 30 * time.Second},
+		{attempt: 100, retryMaxWait: 30 * time.Second, wantMax: 30 * time.Second},
+		{attempt: 2, retryMaxWait: 0, wantMax: 0},
+	}
+
+	for _, tt := range tests {
+		c := &Client{RetryMaxWait: tt.retryMaxWait}
+		for i := 0; i < 100; i++ {
+			got := c.backoff(tt.attempt)
+			if got < 0 || got > tt.wantMax {
+				t.Fatalf("backoff(%d) with RetryMaxWait %s = %s, want within 0-%s", tt.attempt, tt.retryMaxWait, got, tt.wantMax)
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package api
+
+import "context"
+
+// Source of any type, configured as described by the
+// connectionSpecification of its connector. Connectors without a
+// sourceType, e.g. custom ones, are identified by DefinitionId.
+type Source struct {
+	Name          string         `json:"name"`
+	SourceId      string         `json:"sourceId,omitempty"`
+	WorkspaceId   string         `json:"workspaceId"`
+	DefinitionId  string         `json:"definitionId,omitempty"`
+	SourceType    string         `json:"sourceType,omitempty"`
+	Configuration map[string]any `json:"configuration"`
+}
+
+func (c *Client) CreateSource(ctx context.Context, payload Source) (Source, error) {
+	return NewResource[Source](c, sourcesPath).Create(ctx, payload)
+}
+
+func (c *Client) ReadSource(ctx context.Context, sourceId string) (Source, error) {
+	return NewResource[Source](c, sourcesPath).Read(ctx, sourceId)
+}
+
+func (c *Client) UpdateSource(ctx context.Context, payload Source) (Source, error) {
+	sourceId := payload.SourceId
+	payload.SourceId = ""
+	return NewResource[Source](c, sourcesPath).Update(ctx, sourceId, payload)
+}
+
+func (c *Client) DeleteSource(ctx context.Context, sourceId string) error {
+	return NewResource[Source](c, sourcesPath).Delete(ctx, sourceId)
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+type DiscoverSourceSchemaCatalog struct {
+	SourceID      string `json:"sourceId"`
+	DestinationID string `json:"destinationId,omitempty"`
+	DisableCache  bool   `json:"disable_cache"`
+}
+
+type StreamProperties struct {
+	StreamName               string     `json:"streamName"`
+	SyncModes                []string   `json:"syncModes"`
+	DefaultCursorField       []string   `json:"defaultCursorField"`
+	SourceDefinedCursorField bool       `json:"sourceDefinedCursorField"`
+	SourceDefinedPrimaryKey  [][]string `json:"sourceDefinedPrimaryKey"`
+	PropertyFields           [][]string `json:"propertyFields"`
+}
+
+func (c *Client) DiscoverSourceStreams(ctx context.Context, payload DiscoverSourceSchemaCatalog) ([]StreamProperties, error) {
+	// logger := fwhelpers.GetLogger()
+	ctx, cancel := withTimeout(ctx, c.DiscoverTimeout)
+	defer cancel()
+
+	query := url.Values{}
+	query.Set("sourceId", payload.SourceID)
+	if payload.DestinationID != "" {
+		query.Set("destinationId", payload.DestinationID)
+	}
+	query.Set("ignoreCache", strconv.FormatBool(payload.DisableCache))
+
+	streams := []StreamProperties{}
+	err := c.call(ctx, "GET", c.Host+"/v1/streams?"+query.Encode(), nil, &streams)
+	return streams, err
+}
@@ -0,0 +1,33 @@
+package api
+
+import "context"
+
+type Workspace struct {
+	Name          string `json:"name"`
+	WorkspaceId   string `json:"workspaceId,omitempty"`
+	DataResidency string `json:"dataResidency,omitempty"`
+}
+
+func (c *Client) CreateWorkspace(ctx context.Context, payload Workspace) (Workspace, error) {
+	return NewResource[Workspace](c, workspacesPath).Create(ctx, payload)
+}
+
+func (c *Client) ReadWorkspace(ctx context.Context, workspaceId string) (Workspace, error) {
+	return NewResource[Workspace](c, workspacesPath).Read(ctx, workspaceId)
+}
+
+func (c *Client) UpdateWorkspace(ctx context.Context, payload Workspace) (Workspace, error) {
+	workspaceId := payload.WorkspaceId
+	payload.WorkspaceId = ""
+	return NewResource[Workspace](c, workspacesPath).Update(ctx, workspaceId, payload)
+}
+
+func (c *Client) DeleteWorkspace(ctx context.Context, workspaceId string) error {
+	return NewResource[Workspace](c, workspacesPath).Delete(ctx, workspaceId)
+}
+
+// ListWorkspaces returns all the workspaces accessible with the
+// credentials, following the pagination of the API.
+func (c *Client) ListWorkspaces(ctx context.Context) ([]Workspace, error) {
+	return NewResource[Workspace](c, workspacesPath).List(ctx, nil, 0)
+}
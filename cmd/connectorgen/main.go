@@ -0,0 +1,178 @@
+// Connectorgen generates the registry entry of a connector from its
+// Airbyte specification, i.e. the JSON Schema document of its
+// connectionSpecification. It is run by go generate from the plugin
+// package, e.g.
+//
+//	//go:generate go run ../cmd/connectorgen -kind source -type stripe -spec ../specs/source-stripe.json -plugin connector_source_stripe.go
+//
+// The generated registry entry is then added to the connectors list.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	kind := flag.String("kind", "source", "Connector kind, source or destination")
+	connectorType := flag.String("type", "", "Airbyte source or destination type, e.g. google-sheets")
+	specPath := flag.String("spec", "", "Path to the connector specification")
+	pluginPath := flag.String("plugin", "", "Path to the plugin file to generate")
+	flag.Parse()
+
+	if *kind != "source" && *kind != "destination" {
+		fail(fmt.Errorf("-kind should be source or destination, got %q", *kind))
+	}
+	if *connectorType == "" || *specPath == "" || *pluginPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	src, err := generate(*kind, *connectorType, *specPath)
+	if err != nil {
+		fail(err)
+	}
+	err = os.WriteFile(*pluginPath, src, 0644)
+	if err != nil {
+		fail(err)
+	}
+}
+
+// Generate the formatted registry entry of the connector from its
+// specification, the path of which is mentioned in the file header.
+func generate(kind string, connectorType string, specPath string) ([]byte, error) {
+	fields, err := readSpec(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	g := generator{
+		kind:   kind,
+		typ:    connectorType,
+		name:   goName(connectorType),
+		source: filepath.ToSlash(specPath),
+		fields: fields,
+	}
+
+	src, err := format.Source(g.pluginFile())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", specPath, err)
+	}
+	return src, nil
+}
+
+type generator struct {
+	kind   string
+	typ    string
+	name   string
+	source string
+	fields []*field
+}
+
+// Kind as used in the exported Go names, e.g. Source.
+func (g *generator) kindName() string {
+	return goName(g.kind)
+}
+
+func (g *generator) header(b *bytes.Buffer, pkg string) {
+	fmt.Fprintf(b, "// Code generated by connectorgen from %s. DO NOT EDIT.\n\n", g.source)
+	fmt.Fprintf(b, "package %s\n\n", pkg)
+}
+
+// Generate the registry entry of the connector.
+func (g *generator) pluginFile() []byte {
+	b := &bytes.Buffer{}
+	g.header(b, "plugin")
+
+	fmt.Fprintf(b, "var %s%sConnector = connector{\n", g.kind, g.name)
+	fmt.Fprintf(b, "Kind: %sConnector,\n", g.kind)
+	fmt.Fprintf(b, "Type: %q,\n", g.typ)
+	fmt.Fprintf(b, "Description: %q,\n", g.kindName()+" "+g.name+" resource for Airbyte")
+	b.WriteString("Fields: ")
+	g.registryFields(b, g.fields)
+	b.WriteString(",\n}\n")
+
+	return b.Bytes()
+}
+
+func (g *generator) registryFields(b *bytes.Buffer, fields []*field) {
+	b.WriteString("[]connectorField{\n")
+	for _, f := range fields {
+		attrs := []string{
+			"Name: " + strconv.Quote(f.Name),
+			"Type: " + registryType(f.Type),
+			"Description: " + strconv.Quote(f.Title),
+		}
+		if !f.Required {
+			attrs = append(attrs, "Optional: true")
+		}
+		if f.Secret {
+			attrs = append(attrs, "Sensitive: true")
+		}
+		if len(f.Enum) > 0 {
+			enum := []string{}
+			for _, e := range f.Enum {
+				enum = append(enum, strconv.Quote(e))
+			}
+			attrs = append(attrs, "Enum: []string{"+strings.Join(enum, ", ")+"}")
+		}
+		if f.Default != nil {
+			attrs = append(attrs, "Default: "+defaultLiteral(f))
+		}
+		if f.Const != "" {
+			attrs = append(attrs, "Const: "+strconv.Quote(f.Const))
+		}
+		if f.OneOf {
+			attrs = append(attrs, "OneOf: true")
+		}
+
+		b.WriteString("{" + strings.Join(attrs, ", "))
+		if len(f.Fields) > 0 {
+			b.WriteString(", Fields: ")
+			g.registryFields(b, f.Fields)
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}")
+}
+
+func registryType(t string) string {
+	switch t {
+	case "integer":
+		return "intField"
+	case "number":
+		return "floatField"
+	case "boolean":
+		return "boolField"
+	case "object":
+		return "blockField"
+	}
+	return "stringField"
+}
+
+// Helper function to write a default as a Go literal of the field type.
+func defaultLiteral(f *field) string {
+	switch v := f.Default.(type) {
+	case string:
+		return strconv.Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		if f.Type == "integer" {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return "float64(" + strconv.FormatFloat(v, 'g', -1, 64) + ")"
+	}
+	return "nil"
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "connectorgen: %s\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "Update the golden files")
+
+// Helper function to compare the generated source with a golden file.
+func checkGolden(t *testing.T, got []byte, golden string) {
+	t.Helper()
+
+	if *update {
+		err := os.WriteFile(golden, got, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("generated source differs from %s, run go test with -update if intended:\n%s", golden, got)
+	}
+}
+
+// The registry entries under plugin are checked in as generated,
+// from the plugin directory as go generate does.
+func TestGenerateStripe(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chdir(filepath.Join("..", "..", "plugin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	got, err := generate("source", "stripe", "../specs/source-stripe.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, got, "connector_source_stripe.go")
+}
+
+// Covers oneOf alternatives, enums, defaults, constants, secrets,
+// nested objects and the unsupported types being skipped.
+func TestGenerateExample(t *testing.T) {
+	got, err := generate("source", "example", "testdata/source-example.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, got, filepath.Join("testdata", "connector_source_example.golden"))
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+	}{
+		{name: "missing", spec: "testdata/missing.json"},
+		{name: "invalid", spec: "main_test.go"},
+	}
+
+	for _, tt := range tests {
+		_, err := generate("source", "example", tt.spec)
+		if err == nil {
+			t.Errorf("%s: generate(%s) succeeded, want error", tt.name, tt.spec)
+		}
+	}
+}
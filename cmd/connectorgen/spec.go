@@ -0,0 +1,264 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// JSON Schema of a connector specification, limited to the
+// keywords used by Airbyte connectors that are supported.
+type specSchema struct {
+	Type          json.RawMessage        `json:"type"`
+	Title         string                 `json:"title"`
+	Properties    map[string]*specSchema `json:"properties"`
+	Required      []string               `json:"required"`
+	Enum          []any                  `json:"enum"`
+	Const         any                    `json:"const"`
+	Default       any                    `json:"default"`
+	OneOf         []*specSchema          `json:"oneOf"`
+	AirbyteSecret bool                   `json:"airbyte_secret"`
+	Order         *int                   `json:"order"`
+}
+
+// Connector specification as shipped with the connectors, of which
+// the bare connectionSpecification is accepted as well.
+type spec struct {
+	ConnectionSpecification *specSchema `json:"connectionSpecification"`
+}
+
+// Field of the connector configuration, mapped to a registry field.
+type field struct {
+	Name     string
+	Type     string
+	Title    string
+	Required bool
+	Secret   bool
+	Enum     []string
+	Default  any
+	Const    string
+	OneOf    bool
+	Fields   []*field
+}
+
+// Helper function to read the configuration fields of a spec file.
+func readSpec(path string) ([]*field, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := spec{}
+	err = json.Unmarshal(b, &s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	root := s.ConnectionSpecification
+	if root == nil {
+		root = &specSchema{}
+		err = json.Unmarshal(b, root)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	if root.Properties == nil {
+		return nil, fmt.Errorf("%s: no configuration properties found", path)
+	}
+
+	return objectFields("", root), nil
+}
+
+// Helper function to map the properties of an object schema,
+// ordered as in the connector forms.
+func objectFields(path string, s *specSchema) []*field {
+	names := []string{}
+	for name := range s.Properties {
+		// Set from the resource type.
+		if name == "sourceType" || name == "destinationType" {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		oi, oj := propertyOrder(s.Properties[names[i]]), propertyOrder(s.Properties[names[j]])
+		if oi != oj {
+			return oi < oj
+		}
+		return names[i] < names[j]
+	})
+
+	fields := []*field{}
+	for _, name := range names {
+		f := propertyField(path+name, name, s.Properties[name])
+		if f == nil {
+			continue
+		}
+		for _, r := range s.Required {
+			if r == name {
+				f.Required = true
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// Helper function to map a property, returning nil if its
+// type is not supported.
+func propertyField(path string, name string, s *specSchema) *field {
+	f := &field{
+		Name:   name,
+		Title:  s.Title,
+		Secret: s.AirbyteSecret,
+	}
+	if f.Title == "" {
+		f.Title = humanize(name)
+	}
+
+	// Discriminator of a oneOf alternative.
+	if c, ok := s.Const.(string); ok {
+		f.Type = "string"
+		f.Const = c
+		return f
+	}
+	if len(s.Enum) == 1 && s.Default != nil {
+		if c, ok := s.Enum[0].(string); ok {
+			f.Type = "string"
+			f.Const = c
+			return f
+		}
+	}
+
+	if len(s.OneOf) > 0 {
+		f.Type = "object"
+		f.OneOf = true
+		for i, alternative := range s.OneOf {
+			f.Fields = append(f.Fields, alternativeField(path, i, alternative))
+		}
+		return f
+	}
+
+	f.Type = schemaType(s.Type)
+	switch f.Type {
+	case "string", "integer", "number", "boolean":
+	case "object":
+		if len(s.Properties) == 0 {
+			warnf("skipping %s: free-form objects are not supported", path)
+			return nil
+		}
+		f.Fields = objectFields(path+".", s)
+		return f
+	default:
+		warnf("skipping %s: type %q is not supported", path, f.Type)
+		return nil
+	}
+
+	for _, e := range s.Enum {
+		if v, ok := e.(string); ok {
+			f.Enum = append(f.Enum, v)
+		}
+	}
+	switch s.Default.(type) {
+	case string, float64, bool:
+		f.Default = s.Default
+	}
+
+	return f
+}
+
+// Helper function to map an alternative of a oneOf property to a
+// block named after its title, or else its discriminator.
+func alternativeField(path string, index int, s *specSchema) *field {
+	// Presence of the alternatives is checked as a whole.
+	f := &field{
+		Type:     "object",
+		Title:    s.Title,
+		Required: true,
+		Fields:   objectFields(path+".", s),
+	}
+
+	name := snakeCase(s.Title)
+	if name == "" {
+		for _, nested := range f.Fields {
+			if nested.Const != "" {
+				name = snakeCase(nested.Const)
+			}
+		}
+	}
+	if name == "" {
+		name = "option_" + strconv.Itoa(index+1)
+	}
+	f.Name = name
+	if f.Title == "" {
+		f.Title = humanize(name)
+	}
+
+	return f
+}
+
+// Helper function to get the type of a schema, given either as a
+// single type or as a list of types possibly including null.
+func schemaType(raw json.RawMessage) string {
+	var single string
+	if json.Unmarshal(raw, &single) == nil {
+		return single
+	}
+	var multiple []string
+	if json.Unmarshal(raw, &multiple) == nil {
+		for _, t := range multiple {
+			if t != "null" {
+				return t
+			}
+		}
+	}
+	return ""
+}
+
+// Properties without an order follow the ordered ones.
+func propertyOrder(s *specSchema) int {
+	if s.Order == nil {
+		return int(^uint(0) >> 1)
+	}
+	return *s.Order
+}
+
+// Helper function to split a name into its words, at any
+// character other than a letter or digit.
+func words(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Helper function to convert a connector type to a Go identifier,
+// e.g. google-sheets to GoogleSheets.
+func goName(name string) string {
+	s := ""
+	for _, w := range words(name) {
+		s += strings.ToUpper(w[:1]) + w[1:]
+	}
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "F" + s
+	}
+	return s
+}
+
+func snakeCase(name string) string {
+	return strings.ToLower(strings.Join(words(name), "_"))
+}
+
+func humanize(name string) string {
+	s := strings.Join(words(name), " ")
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func warnf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "connectorgen: "+format+"\n", args...)
+}
@@ -4,11 +4,13 @@ go 1.19
 
 replace github.com/zclconf/go-cty v1.13.1 => github.com/zipstack/go-cty v1.13.1-pct.1
 
-require github.com/zipstack/pct-plugin-framework v1.1.0
+require (
+	github.com/zclconf/go-cty v1.13.1
+	github.com/zipstack/pct-plugin-framework v1.1.0
+)
 
 require (
 	github.com/apparentlymart/go-textseg/v13 v13.0.0 // indirect
 	github.com/valyala/gorpc v0.0.0-20160519171614-908281bef774 // indirect
-	github.com/zclconf/go-cty v1.13.1 // indirect
 	golang.org/x/text v0.9.0 // indirect
 )
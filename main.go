@@ -1,6 +1,10 @@
 package main
 
 import (
+	"context"
+	"os/signal"
+	"syscall"
+
 	"github.com/zipstack/pct-plugin-framework/schema"
 	"github.com/zipstack/pct-plugin-framework/server"
 
@@ -11,24 +15,32 @@ import (
 var version string
 
 func main() {
-	server.Serve(version, plugin.NewProvider, []func() schema.ResourceService{
-		//Source connectors
-		plugin.NewSourcePipedriveResource,
-		plugin.NewSourceStripeResource,
-		plugin.NewSourceAmplitudeResource,
-		plugin.NewSourceShopifyResource,
-		plugin.NewSourceFreshdeskResource,
-		plugin.NewSourceZendeskSupportResource,
-		plugin.NewSourceHubspotResource,
-		plugin.NewSourceGoogleAnalyticsV4Resource,
-		plugin.NewSourceGoogleSheetsResource,
-		plugin.NewSourceFacebookMarketingResource,
-
-		//Destination Connectors
-		plugin.NewDestinationMysqlResource,
-		plugin.NewDestinationPostgresResource,
+	// Abort the API requests in flight when the apply is cancelled.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+	plugin.SetBaseContext(ctx)
+
+	resources := []func() schema.ResourceService{
+		//Workspaces
+		plugin.NewWorkspaceResource,
 
 		//Connections
 		plugin.NewConnectionResource,
-	})
+
+		//Jobs
+		plugin.NewJobResource,
+
+		//Read-only lookups
+		plugin.NewSourceStreamsResource,
+		plugin.NewWorkspaceLookupResource,
+		plugin.NewJobsResource,
+	}
+
+	//Source and destination connectors, from the registry
+	resources = append(resources, plugin.ConnectorResources()...)
+
+	//Sources of any type, with free-form configuration
+	resources = append(resources, plugin.NewSourceResource)
+
+	server.Serve(version, plugin.NewProvider, resources)
 }
@@ -2,6 +2,8 @@ package plugin
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/zipstack/pct-plugin-framework/fwhelpers"
@@ -15,17 +17,20 @@ type connectionResource struct {
 }
 
 type connectionResourceModel struct {
-	Name                             string           `pctsdk:"name"`
-	SourceID                         string           `pctsdk:"source_id"`
-	DestinationID                    string           `pctsdk:"destination_id"`
-	ConnectionID                     string           `pctsdk:"connection_id"`
-	DataResidency                    string           `pctsdk:"data_residency"`
-	NamespaceDefinition              string           `pctsdk:"namespace_definition"`
-	NamespaceFormat                  string           `pctsdk:"namespace_format"`
-	NonBreakingSchemaUpdatesBehavior string           `pctsdk:"nonBreakingSchemaUpdatesBehavior"`
-	Prefix                           string           `pctsdk:"prefix"`
-	Status                           string           `pctsdk:"status"`
-	Schedule                         connScheduleData `pctsdk:"schedule"`
+	Name                             string             `pctsdk:"name"`
+	SourceID                         string             `pctsdk:"source_id"`
+	DestinationID                    string             `pctsdk:"destination_id"`
+	ConnectionID                     string             `pctsdk:"connection_id"`
+	DataResidency                    string             `pctsdk:"data_residency"`
+	NamespaceDefinition              string             `pctsdk:"namespace_definition"`
+	NamespaceFormat                  string             `pctsdk:"namespace_format"`
+	NonBreakingSchemaUpdatesBehavior string             `pctsdk:"nonBreakingSchemaUpdatesBehavior"`
+	Prefix                           string             `pctsdk:"prefix"`
+	Status                           string             `pctsdk:"status"`
+	Schedule                         connScheduleData   `pctsdk:"schedule"`
+	Configurations                   connConfigurations `pctsdk:"configurations,omitempty"`
+	ResetOnBreakingChange            bool               `pctsdk:"reset_on_breaking_change,omitempty"`
+	ResetWaitTimeout                 int                `pctsdk:"reset_wait_timeout,omitempty"`
 	// OperatorConfiguration connOperatorConfig `pctsdk:"operator_configuration"`
 }
 
@@ -34,6 +39,17 @@ type connScheduleData struct {
 	CronExpression string `pctsdk:"cron_expression"`
 }
 
+type connConfigurations struct {
+	Streams []connStreamConfiguration `pctsdk:"streams"`
+}
+
+type connStreamConfiguration struct {
+	Name        string     `pctsdk:"name"`
+	SyncMode    string     `pctsdk:"sync_mode"`
+	CursorField []string   `pctsdk:"cursor_field"`
+	PrimaryKey  [][]string `pctsdk:"primary_key"`
+}
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ schema.ResourceService = &connectionResource{}
@@ -64,9 +80,7 @@ func (r *connectionResource) Configure(req *schema.ServiceRequest) *schema.Servi
 		return schema.ErrorResponse(err)
 	}
 
-	client, err := api.NewClient(
-		creds["host"], creds["authorization"],
-	)
+	client, err := newClient(creds)
 	if err != nil {
 		return schema.ErrorResponse(fmt.Errorf("malformed data provided to configure resource"))
 	}
@@ -106,16 +120,77 @@ func (r *connectionResource) Schema() *schema.ServiceResponse {
 				Required:    true,
 				Attributes: map[string]schema.Attribute{
 					"schedule_type": &schema.StringAttribute{
-						Description: "schedule Type",
+						Description: "schedule Type. One of manual, cron or basic",
 						Required:    true,
 					},
 					"cron_expression": &schema.StringAttribute{
-						Description: "cron Expression",
+						Description: "cron Expression in Quartz syntax, e.g. 0 0 12 * * ?. Required for cron schedule type",
 						Required:    true,
 						Optional:    true,
 					},
 				},
 			},
+			"configurations": &schema.MapAttribute{
+				Description: "Stream configurations",
+				Required:    true,
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"streams": &schema.ListAttribute{
+						Description: "Streams to be synced. Airbyte selects streams by default if not set.",
+						Required:    true,
+						Optional:    true,
+						NestedAttribute: &schema.MapAttribute{
+							Description: "Stream",
+							Required:    true,
+							Attributes: map[string]schema.Attribute{
+								"name": &schema.StringAttribute{
+									Description: "Stream name",
+									Required:    true,
+								},
+								"sync_mode": &schema.StringAttribute{
+									Description: "Sync mode. One of full_refresh_overwrite, full_refresh_append, incremental_append or incremental_deduped_history",
+									Required:    true,
+									Optional:    true,
+								},
+								"cursor_field": &schema.ListAttribute{
+									Description: "Path to the cursor field",
+									Required:    true,
+									Optional:    true,
+									NestedAttribute: &schema.StringAttribute{
+										Description: "Path segment",
+										Required:    true,
+									},
+								},
+								"primary_key": &schema.ListAttribute{
+									Description: "Paths to the primary key fields",
+									Required:    true,
+									Optional:    true,
+									NestedAttribute: &schema.ListAttribute{
+										Description: "Path to a primary key field",
+										Required:    true,
+										NestedAttribute: &schema.StringAttribute{
+											Description: "Path segment",
+											Required:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"reset_on_breaking_change": &schema.BoolAttribute{
+				Description: "Reset the connection when the configured sync mode or primary key of a stream changes. " +
+					"DESTRUCTIVE: the reset runs during apply, once the connection is updated, and clears all the data " +
+					"synced to the destination. The plan does not show which streams trigger it.",
+				Required: true,
+				Optional: true,
+			},
+			"reset_wait_timeout": &schema.IntAttribute{
+				Description: "Seconds to wait for the reset job to finish. Defaults to 3600",
+				Required:    true,
+				Optional:    true,
+			},
 			"data_residency": &schema.StringAttribute{
 				Description: "Data Residency",
 				Required:    true,
@@ -164,6 +239,11 @@ func (r *connectionResource) Create(req *schema.ServiceRequest) *schema.ServiceR
 		return schema.ErrorResponse(err)
 	}
 
+	err = validateConnSchedule(plan.Schedule)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
 	body := api.ConnectionResource{}
 	body.Name = plan.Name
 	body.SourceID = plan.SourceID
@@ -180,7 +260,9 @@ func (r *connectionResource) Create(req *schema.ServiceRequest) *schema.ServiceR
 	body.Schedule.ScheduleType = plan.Schedule.ScheduleType
 	body.Schedule.CronExpression = plan.Schedule.CronExpression
 
-	connection, err := r.Client.CreateConnectionResource(body)
+	body.Configurations = connConfigurationsToAPI(plan.Configurations)
+
+	connection, err := r.Client.CreateConnectionResource(baseContext, body)
 	if err != nil {
 		return schema.ErrorResponse(err)
 	}
@@ -193,17 +275,21 @@ func (r *connectionResource) Create(req *schema.ServiceRequest) *schema.ServiceR
 	state.SourceID = connection.SourceID
 	state.DestinationID = connection.DestinationID
 
-	state.DataResidency = connection.DataResidency
+	state.DataResidency = connOptionalAttribute(connection.DataResidency, plan.DataResidency, false)
 	state.Status = connection.Status
-	state.NamespaceDefinition = connection.NamespaceDefinition
-	state.NamespaceFormat = connection.NamespaceFormat
-	state.NonBreakingSchemaUpdatesBehavior = connection.NonBreakingSchemaUpdatesBehavior
-	state.Prefix = connection.Prefix
+	state.NamespaceDefinition = connOptionalAttribute(connection.NamespaceDefinition, plan.NamespaceDefinition, false)
+	state.NamespaceFormat = connOptionalAttribute(connection.NamespaceFormat, plan.NamespaceFormat, false)
+	state.NonBreakingSchemaUpdatesBehavior = connOptionalAttribute(connection.NonBreakingSchemaUpdatesBehavior, plan.NonBreakingSchemaUpdatesBehavior, false)
+	state.Prefix = connOptionalAttribute(connection.Prefix, plan.Prefix, false)
 
 	state.Schedule = connScheduleData{}
 	state.Schedule.ScheduleType = connection.Schedule.ScheduleType
 	state.Schedule.CronExpression = connection.Schedule.CronExpression
 
+	state.Configurations = connConfigurationsFromAPI(connection.Configurations, plan.Configurations, false)
+	state.ResetOnBreakingChange = plan.ResetOnBreakingChange
+	state.ResetWaitTimeout = plan.ResetWaitTimeout
+
 	stateEnc, err := fwhelpers.PackModel(nil, &state)
 	if err != nil {
 		return schema.ErrorResponse(err)
@@ -220,22 +306,38 @@ func (r *connectionResource) Create(req *schema.ServiceRequest) *schema.ServiceR
 func (r *connectionResource) Read(req *schema.ServiceRequest) *schema.ServiceResponse {
 	var state connectionResourceModel
 
-	// Get current state
-	err := fwhelpers.UnpackModel(req.StateContents, &state)
-	if err != nil {
-		return schema.ErrorResponse(err)
+	// Get current state, which is empty when importing by ID
+	importing := req.StateContents == ""
+	if !importing {
+		err := fwhelpers.UnpackModel(req.StateContents, &state)
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
 	}
 
 	res := schema.ServiceResponse{}
 
 	if req.StateID != "" {
 		// Query using existing previous state.
-		connection, err := r.Client.ReadConnectionResource(req.StateID)
+		connection, err := r.Client.ReadConnectionResource(baseContext, req.StateID)
+		if api.IsNotFound(err) {
+			if importing {
+				return schema.ErrorResponse(fmt.Errorf("connection %s not found", req.StateID))
+			}
+			// Removed outside of PCT, empty StateID plans a re-create.
+			return &schema.ServiceResponse{
+				StateContents: req.StateContents,
+			}
+		}
 		if err != nil {
 			return schema.ErrorResponse(err)
 		}
 
+		// Reset settings are not known to Airbyte, retain them from state
+		previous := state
 		state = connectionResourceModel{}
+		state.ResetOnBreakingChange = previous.ResetOnBreakingChange
+		state.ResetWaitTimeout = previous.ResetWaitTimeout
 
 		// Update state with refreshed value
 		state.Name = connection.Name
@@ -243,17 +345,19 @@ func (r *connectionResource) Read(req *schema.ServiceRequest) *schema.ServiceRes
 		state.SourceID = connection.SourceID
 		state.DestinationID = connection.DestinationID
 
-		state.DataResidency = connection.DataResidency
+		state.DataResidency = connOptionalAttribute(connection.DataResidency, previous.DataResidency, importing)
 		state.Status = connection.Status
-		state.NamespaceDefinition = connection.NamespaceDefinition
-		state.NamespaceFormat = connection.NamespaceFormat
-		state.NonBreakingSchemaUpdatesBehavior = connection.NonBreakingSchemaUpdatesBehavior
-		state.Prefix = connection.Prefix
+		state.NamespaceDefinition = connOptionalAttribute(connection.NamespaceDefinition, previous.NamespaceDefinition, importing)
+		state.NamespaceFormat = connOptionalAttribute(connection.NamespaceFormat, previous.NamespaceFormat, importing)
+		state.NonBreakingSchemaUpdatesBehavior = connOptionalAttribute(connection.NonBreakingSchemaUpdatesBehavior, previous.NonBreakingSchemaUpdatesBehavior, importing)
+		state.Prefix = connOptionalAttribute(connection.Prefix, previous.Prefix, importing)
 
 		state.Schedule = connScheduleData{}
 		state.Schedule.ScheduleType = connection.Schedule.ScheduleType
 		state.Schedule.CronExpression = connection.Schedule.CronExpression
 
+		state.Configurations = connConfigurationsFromAPI(connection.Configurations, previous.Configurations, importing)
+
 		res.StateID = connection.ConnectionID
 	} else {
 		// No previous state exists.
@@ -272,82 +376,319 @@ func (r *connectionResource) Read(req *schema.ServiceRequest) *schema.ServiceRes
 }
 
 func (r *connectionResource) Update(req *schema.ServiceRequest) *schema.ServiceResponse {
+	logger := fwhelpers.GetLogger()
+
 	var plan connectionResourceModel
 	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
 	if err != nil {
 		return schema.ErrorResponse(err)
 	}
 
-	// Generate API request body from plan
-	body := api.ConnectionResource{}
+	err = validateConnSchedule(plan.Schedule)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
 
-	body.Name = plan.Name
-	body.ConnectionID = plan.ConnectionID
-	body.SourceID = plan.SourceID
-	body.DestinationID = plan.DestinationID
+	// Get current state to find the changed attributes
+	var state connectionResourceModel
+	if req.StateContents != "" {
+		err = fwhelpers.UnpackModel(req.StateContents, &state)
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+	}
 
-	body.DataResidency = plan.DataResidency
-	body.Status = plan.Status
-	body.NamespaceDefinition = plan.NamespaceDefinition
-	body.NamespaceFormat = plan.NamespaceFormat
-	body.NonBreakingSchemaUpdatesBehavior = plan.NonBreakingSchemaUpdatesBehavior
-	body.Prefix = plan.Prefix
+	if state.SourceID != "" && state.SourceID != plan.SourceID {
+		return schema.ErrorResponse(fmt.Errorf("source_id of an existing connection cannot be changed"))
+	}
+	if state.DestinationID != "" && state.DestinationID != plan.DestinationID {
+		return schema.ErrorResponse(fmt.Errorf("destination_id of an existing connection cannot be changed"))
+	}
 
-	body.Schedule = api.ConnScheduleData{}
-	body.Schedule.ScheduleType = plan.Schedule.ScheduleType
-	body.Schedule.CronExpression = plan.Schedule.CronExpression
+	// Generate API request body from the changed attributes only,
+	// optional attributes left unset retaining their remote value
+	body := api.ConnectionPatch{}
 
-	// Update existing source
-	_, err = r.Client.UpdateConnectionResource(body)
-	if err != nil {
-		return schema.ErrorResponse(err)
+	if plan.Name != state.Name {
+		body.Name = &plan.Name
+	}
+	if plan.DataResidency != "" && plan.DataResidency != state.DataResidency {
+		body.DataResidency = &plan.DataResidency
+	}
+	if plan.Status != state.Status {
+		body.Status = &plan.Status
+	}
+	if plan.NamespaceDefinition != "" && plan.NamespaceDefinition != state.NamespaceDefinition {
+		body.NamespaceDefinition = &plan.NamespaceDefinition
+	}
+	if plan.NamespaceFormat != "" && plan.NamespaceFormat != state.NamespaceFormat {
+		body.NamespaceFormat = &plan.NamespaceFormat
+	}
+	if plan.NonBreakingSchemaUpdatesBehavior != "" && plan.NonBreakingSchemaUpdatesBehavior != state.NonBreakingSchemaUpdatesBehavior {
+		body.NonBreakingSchemaUpdatesBehavior = &plan.NonBreakingSchemaUpdatesBehavior
+	}
+	if plan.Prefix != "" && plan.Prefix != state.Prefix {
+		body.Prefix = &plan.Prefix
+	}
+	if plan.Schedule != state.Schedule {
+		body.Schedule = &api.ConnScheduleData{}
+		body.Schedule.ScheduleType = plan.Schedule.ScheduleType
+		body.Schedule.CronExpression = plan.Schedule.CronExpression
+	}
+	if !reflect.DeepEqual(normalizeConnConfigurations(plan.Configurations), normalizeConnConfigurations(state.Configurations)) {
+		configurations := connConfigurationsToAPI(plan.Configurations)
+		body.Configurations = &configurations
 	}
 
-	// Fetch updated items
-	connection, err := r.Client.ReadConnectionResource(req.PlanID)
+	// Compare the configured streams with the ones in use, which
+	// state only holds as far as they are configured.
+	breakingStreams := []string{}
+	if body.Configurations != nil {
+		current, err := r.Client.ReadConnectionResource(baseContext, req.PlanID)
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+		breakingStreams = connBreakingStreams(state.Configurations, current.Configurations, plan.Configurations)
+	}
+	if len(breakingStreams) > 0 && !plan.ResetOnBreakingChange {
+		logger.Printf(
+			"WARNING: sync mode or primary key changed for streams %s of connection %s. "+
+				"Data synced so far may be inconsistent until the connection is reset.",
+			strings.Join(breakingStreams, ", "), req.PlanID,
+		)
+	}
+
+	// Update existing connection
+	connection, err := r.Client.UpdateConnectionResource(baseContext, req.PlanID, body)
 	if err != nil {
 		return schema.ErrorResponse(err)
 	}
 
 	// Update state with refreshed value
-	state := connectionResourceModel{}
+	state = connectionResourceModel{}
 
 	state.Name = connection.Name
 	state.ConnectionID = connection.ConnectionID
 	state.SourceID = connection.SourceID
 	state.DestinationID = connection.DestinationID
 
-	state.DataResidency = connection.DataResidency
+	state.DataResidency = connOptionalAttribute(connection.DataResidency, plan.DataResidency, false)
 	state.Status = connection.Status
-	state.NamespaceDefinition = connection.NamespaceDefinition
-	state.NamespaceFormat = connection.NamespaceFormat
-	state.NonBreakingSchemaUpdatesBehavior = connection.NonBreakingSchemaUpdatesBehavior
-	state.Prefix = connection.Prefix
+	state.NamespaceDefinition = connOptionalAttribute(connection.NamespaceDefinition, plan.NamespaceDefinition, false)
+	state.NamespaceFormat = connOptionalAttribute(connection.NamespaceFormat, plan.NamespaceFormat, false)
+	state.NonBreakingSchemaUpdatesBehavior = connOptionalAttribute(connection.NonBreakingSchemaUpdatesBehavior, plan.NonBreakingSchemaUpdatesBehavior, false)
+	state.Prefix = connOptionalAttribute(connection.Prefix, plan.Prefix, false)
 
 	state.Schedule = connScheduleData{}
 	state.Schedule.ScheduleType = connection.Schedule.ScheduleType
 	state.Schedule.CronExpression = connection.Schedule.CronExpression
 
+	state.Configurations = connConfigurationsFromAPI(connection.Configurations, plan.Configurations, false)
+	state.ResetOnBreakingChange = plan.ResetOnBreakingChange
+	state.ResetWaitTimeout = plan.ResetWaitTimeout
+
 	// Set refreshed state
 	stateEnc, err := fwhelpers.PackModel(nil, &state)
 	if err != nil {
 		return schema.ErrorResponse(err)
 	}
 
-	return &schema.ServiceResponse{
+	res := &schema.ServiceResponse{
 		StateID:          state.ConnectionID,
 		StateContents:    stateEnc,
 		StateLastUpdated: time.Now().Format(time.RFC850),
 	}
+
+	if len(breakingStreams) > 0 && plan.ResetOnBreakingChange {
+		logger.Printf(
+			"WARNING: resetting connection %s for changes to streams %s. "+
+				"All the data synced to the destination is cleared.",
+			req.PlanID, strings.Join(breakingStreams, ", "),
+		)
+
+		// The connection is updated already, its state is kept on failure.
+		err = r.reset(req.PlanID, plan.ResetWaitTimeout)
+		if err != nil {
+			res.ErrorsContents = schema.ErrorResponse(err).ErrorsContents
+		}
+	}
+
+	return res
+}
+
+// Helper function to refresh an optional attribute, which Airbyte fills
+// in with its default when left unset, e.g. auto for data residency.
+// Unset attributes are kept unset in state as configured, except when
+// importing.
+func connOptionalAttribute(remote string, configured string, importing bool) string {
+	if configured == "" && !importing {
+		return ""
+	}
+	return remote
+}
+
+// Helper function to reset the connection and wait for it to finish.
+func (r *connectionResource) reset(connectionId string, waitTimeoutSeconds int) error {
+	waitTimeout := defaultJobWaitTimeout
+	if waitTimeoutSeconds > 0 {
+		waitTimeout = time.Duration(waitTimeoutSeconds) * time.Second
+	}
+
+	job, err := r.Client.CreateJob(baseContext, api.Job{ConnectionId: connectionId, JobType: "reset"})
+	if err != nil {
+		return err
+	}
+	job, err = r.Client.WaitForJob(baseContext, job.JobId, jobPollInterval, waitTimeout)
+	if err != nil {
+		return err
+	}
+	if job.Status != "succeeded" {
+		return fmt.Errorf("reset job %d finished with status %s", job.JobId, job.Status)
+	}
+	return nil
 }
 
 // Delete deletes the resource and removes the state on success.
 func (r *connectionResource) Delete(req *schema.ServiceRequest) *schema.ServiceResponse {
 	// Delete existing source
-	err := r.Client.DeleteConnectionResource(req.StateID)
-	if err != nil {
+	err := r.Client.DeleteConnectionResource(baseContext, req.StateID)
+	if err != nil && !api.IsNotFound(err) {
 		return schema.ErrorResponse(err)
 	}
 
 	return &schema.ServiceResponse{}
 }
+
+// Map stream configurations from schema model to API request body.
+func connConfigurationsToAPI(c connConfigurations) api.ConnConfigurations {
+	configurations := api.ConnConfigurations{}
+	for _, stream := range c.Streams {
+		configurations.Streams = append(configurations.Streams, api.ConnStreamConfiguration{
+			Name:        stream.Name,
+			SyncMode:    stream.SyncMode,
+			CursorField: stream.CursorField,
+			PrimaryKey:  stream.PrimaryKey,
+		})
+	}
+	return configurations
+}
+
+// Map stream configurations from API response body to schema model.
+// Airbyte returns all the selected streams with every attribute filled
+// in, so only the configured streams and attributes are refreshed, the
+// others being kept as configured. All the streams are refreshed when
+// importing.
+func connConfigurationsFromAPI(c api.ConnConfigurations, configured connConfigurations, importing bool) connConfigurations {
+	configurations := connConfigurations{}
+	if importing {
+		for _, stream := range c.Streams {
+			configurations.Streams = append(configurations.Streams, connStreamConfiguration{
+				Name:        stream.Name,
+				SyncMode:    stream.SyncMode,
+				CursorField: stream.CursorField,
+				PrimaryKey:  stream.PrimaryKey,
+			})
+		}
+		return configurations
+	}
+
+	remoteStreams := map[string]api.ConnStreamConfiguration{}
+	for _, stream := range c.Streams {
+		remoteStreams[stream.Name] = stream
+	}
+
+	for _, stream := range configured.Streams {
+		remote, ok := remoteStreams[stream.Name]
+		if !ok {
+			// Deselected outside of PCT, planned to be selected again.
+			continue
+		}
+		if stream.SyncMode != "" {
+			stream.SyncMode = remote.SyncMode
+		}
+		if len(stream.CursorField) > 0 {
+			stream.CursorField = remote.CursorField
+		}
+		if len(stream.PrimaryKey) > 0 {
+			stream.PrimaryKey = remote.PrimaryKey
+		}
+		configurations.Streams = append(configurations.Streams, stream)
+	}
+	return configurations
+}
+
+// Helper function to normalise stream configurations for comparison,
+// empty lists being the same as unset ones.
+func normalizeConnConfigurations(c connConfigurations) connConfigurations {
+	configurations := connConfigurations{}
+	for _, stream := range c.Streams {
+		if len(stream.CursorField) == 0 {
+			stream.CursorField = nil
+		}
+		stream.PrimaryKey = normalizePrimaryKey(stream.PrimaryKey)
+		configurations.Streams = append(configurations.Streams, stream)
+	}
+	return configurations
+}
+
+// Helper function to find the streams whose sync mode or primary key
+// changed, which requires a reset for the destination to stay consistent.
+// Only the attributes set in the plan are compared with their value in
+// use, and streams new to the plan are not breaking.
+func connBreakingStreams(previous connConfigurations, current api.ConnConfigurations, planned connConfigurations) []string {
+	previousStreams := map[string]bool{}
+	for _, stream := range previous.Streams {
+		previousStreams[stream.Name] = true
+	}
+	currentStreams := map[string]api.ConnStreamConfiguration{}
+	for _, stream := range current.Streams {
+		currentStreams[stream.Name] = stream
+	}
+
+	breaking := []string{}
+	for _, stream := range planned.Streams {
+		currentStream, ok := currentStreams[stream.Name]
+		if !ok || !previousStreams[stream.Name] {
+			continue
+		}
+		syncModeChanged := stream.SyncMode != "" && stream.SyncMode != currentStream.SyncMode
+		primaryKeyChanged := len(stream.PrimaryKey) > 0 &&
+			!reflect.DeepEqual(normalizePrimaryKey(stream.PrimaryKey), normalizePrimaryKey(currentStream.PrimaryKey))
+		if syncModeChanged || primaryKeyChanged {
+			breaking = append(breaking, stream.Name)
+		}
+	}
+	return breaking
+}
+
+func normalizePrimaryKey(primaryKey [][]string) [][]string {
+	normalized := [][]string(nil)
+	for _, path := range primaryKey {
+		if len(path) == 0 {
+			path = nil
+		}
+		normalized = append(normalized, path)
+	}
+	return normalized
+}
+
+// Helper function to validate the schedule before any API call.
+func validateConnSchedule(schedule connScheduleData) error {
+	switch schedule.ScheduleType {
+	case "cron":
+		if schedule.CronExpression == "" {
+			return fmt.Errorf("schedule.cron_expression: required for schedule_type cron")
+		}
+		err := validateQuartzCron(schedule.CronExpression)
+		if err != nil {
+			return fmt.Errorf("schedule.cron_expression: %s", err)
+		}
+	case "manual", "basic":
+		if schedule.CronExpression != "" {
+			return fmt.Errorf("schedule.cron_expression: only allowed for schedule_type cron, found %s", schedule.ScheduleType)
+		}
+	default:
+		return fmt.Errorf("schedule.schedule_type: should be one of manual, cron or basic, found %q", schedule.ScheduleType)
+	}
+	return nil
+}
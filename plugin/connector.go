@@ -0,0 +1,741 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/zipstack/pct-plugin-framework/fwhelpers"
+	"github.com/zipstack/pct-plugin-framework/schema"
+
+	"github.com/zipstack/pct-provider-airbyte-cloud/api"
+)
+
+// Kinds of connectors.
+const (
+	sourceConnector      = "source"
+	destinationConnector = "destination"
+)
+
+// Type of value held by a connector field.
+type fieldType int
+
+const (
+	stringField fieldType = iota
+	intField
+	floatField
+	boolField
+	blockField
+)
+
+// Connector describes a source or destination resource, served by
+// connectorResource. The resource type name is the kind followed by
+// the Airbyte type, e.g. source_google_sheets for google-sheets.
+type connector struct {
+	Kind        string
+	Type        string
+	Description string
+	Fields      []connectorField
+}
+
+// Field of the connector configuration, named as the corresponding
+// key of the Airbyte configuration. Optional fields left unset, i.e.
+// with a zero value, are not sent to Airbyte.
+type connectorField struct {
+	Name        string
+	Type        fieldType
+	Description string
+	Optional    bool
+	Sensitive   bool
+
+	// Allowed values of a string field.
+	Enum []string
+
+	// Value applied by Airbyte when the field is left unset.
+	Default any
+
+	// Value always sent for the field, which is not exposed as an
+	// attribute, e.g. the discriminator of a oneOf alternative.
+	Const string
+
+	// Attributes of a nested block.
+	Fields []connectorField
+
+	// Whether the nested blocks are alternatives, exactly one of
+	// which is to be set, as for oneOf in connector specifications.
+	OneOf bool
+}
+
+// Resource implementation for any connector of the registry.
+type connectorResource struct {
+	Client    *api.Client
+	connector connector
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ schema.ResourceService = &connectorResource{}
+)
+
+// ConnectorResources returns a resource service for each
+// connector of the registry.
+func ConnectorResources() []func() schema.ResourceService {
+	services := []func() schema.ResourceService{}
+	for _, c := range connectors {
+		c := c
+		services = append(services, func() schema.ResourceService {
+			return &connectorResource{connector: c}
+		})
+	}
+	return services
+}
+
+// Metadata returns the resource type name.
+// It is always provider name + "_" + resource type name.
+func (r *connectorResource) Metadata(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{
+		TypeName: req.TypeName + "_" + r.connector.Kind + "_" + strings.ReplaceAll(r.connector.Type, "-", "_"),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *connectorResource) Configure(req *schema.ServiceRequest) *schema.ServiceResponse {
+	if req.ResourceData == "" {
+		return schema.ErrorResponse(fmt.Errorf("no data provided to configure resource"))
+	}
+
+	var creds map[string]string
+	err := fwhelpers.Decode(req.ResourceData, &creds)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	client, err := newClient(creds)
+	if err != nil {
+		return schema.ErrorResponse(fmt.Errorf("malformed data provided to configure resource"))
+	}
+
+	r.Client = client
+
+	return &schema.ServiceResponse{}
+}
+
+// Schema defines the schema for the resource.
+func (r *connectorResource) Schema() *schema.ServiceResponse {
+	kind := r.connector.Kind
+	title := strings.ToUpper(kind[:1]) + kind[1:]
+
+	configAttrs := connectorSchemaAttributes(r.connector.Fields)
+	configAttrs[r.typeAttribute()] = &schema.StringAttribute{
+		Description: title + " Type",
+		Required:    true,
+	}
+
+	s := &schema.Schema{
+		Description: r.connector.Description,
+		Attributes: map[string]schema.Attribute{
+			"name": &schema.StringAttribute{
+				Description: "Name",
+				Required:    true,
+			},
+			r.idAttribute(): &schema.StringAttribute{
+				Description: title + " ID",
+				Required:    false,
+				Computed:    true,
+			},
+			"workspace_id": &schema.StringAttribute{
+				Description: "Workspace ID",
+				Required:    true,
+			},
+			"configuration": &schema.MapAttribute{
+				Description: "Connection configuration",
+				Required:    true,
+				Attributes:  configAttrs,
+			},
+		},
+	}
+
+	sEnc, err := fwhelpers.Encode(s)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		SchemaContents: sEnc,
+	}
+}
+
+// Create a new resource
+func (r *connectorResource) Create(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan cty.Value
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	body, err := r.toAPI(plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Create new source or destination
+	remote, err := r.create(body)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Update resource state with response body and planned configuration
+	stateEnc, err := r.packState(remote, ctyAttr(plan, "configuration"))
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          remote.Id,
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
+
+// Read resource information
+func (r *connectorResource) Read(req *schema.ServiceRequest) *schema.ServiceResponse {
+	state := cty.NullVal(cty.DynamicPseudoType)
+
+	// Get current state, which is empty when importing by ID
+	importing := req.StateContents == ""
+	if !importing {
+		err := fwhelpers.UnpackModel(req.StateContents, &state)
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+	}
+
+	if req.StateID == "" {
+		// No previous state exists.
+		return &schema.ServiceResponse{
+			StateContents: req.StateContents,
+		}
+	}
+
+	// Query using existing previous state.
+	remote, err := r.read(req.StateID)
+	if api.IsNotFound(err) {
+		if importing {
+			return schema.ErrorResponse(fmt.Errorf("%s %s not found", r.connector.Kind, req.StateID))
+		}
+		// Removed outside of PCT, empty StateID plans a re-create.
+		return &schema.ServiceResponse{
+			StateContents: req.StateContents,
+		}
+	}
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	if importing {
+		err = checkResourceType(r.connector.Kind, req.StateID, r.connector.Type, remote.Type)
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+	}
+
+	// Refresh configuration, retaining secrets masked in response
+	current := ctyAttr(state, "configuration")
+	config := connectorConfigFromAPI(r.connector.Fields, remote.Configuration, current)
+	connectorType := remote.Type
+	if connectorType == "" {
+		connectorType = ctyString(ctyAttr(current, r.typeAttribute()))
+	}
+	config[r.typeAttribute()] = cty.StringVal(connectorType)
+
+	// Set refreshed state
+	stateEnc, err := r.packState(remote, cty.ObjectVal(config))
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:       remote.Id,
+		StateContents: stateEnc,
+	}
+}
+
+func (r *connectorResource) Update(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan cty.Value
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	body, err := r.toAPI(plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	body.Id = req.PlanID
+
+	// Update existing source or destination
+	remote, err := r.update(body)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Update state with refreshed value
+	stateEnc, err := r.packState(remote, ctyAttr(plan, "configuration"))
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          remote.Id,
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
+
+// Delete deletes the resource and removes the state on success.
+func (r *connectorResource) Delete(req *schema.ServiceRequest) *schema.ServiceResponse {
+	err := r.delete(req.StateID)
+	if err != nil && !api.IsNotFound(err) {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{}
+}
+
+// Name of the attribute holding the source or destination ID.
+func (r *connectorResource) idAttribute() string {
+	return r.connector.Kind + "_id"
+}
+
+// Name of the configuration attribute holding the connector type.
+func (r *connectorResource) typeAttribute() string {
+	return r.connector.Kind + "_type"
+}
+
+// Source or destination as exchanged with Airbyte API.
+type connectorData struct {
+	Id            string
+	Name          string
+	WorkspaceId   string
+	Type          string
+	Configuration map[string]any
+}
+
+// Generate API request body from plan
+func (r *connectorResource) toAPI(plan cty.Value) (connectorData, error) {
+	planConfig := ctyAttr(plan, "configuration")
+
+	config, err := connectorConfigToAPI(r.connector.Fields, planConfig, "configuration.")
+	if err != nil {
+		return connectorData{}, err
+	}
+	config[r.connector.Kind+"Type"] = ctyString(ctyAttr(planConfig, r.typeAttribute()))
+
+	return connectorData{
+		Name:          ctyString(ctyAttr(plan, "name")),
+		WorkspaceId:   ctyString(ctyAttr(plan, "workspace_id")),
+		Configuration: config,
+	}, nil
+}
+
+// Pack the state from the API response and the given configuration.
+func (r *connectorResource) packState(remote connectorData, config cty.Value) (string, error) {
+	state := cty.ObjectVal(map[string]cty.Value{
+		"name":          cty.StringVal(remote.Name),
+		r.idAttribute(): cty.StringVal(remote.Id),
+		"workspace_id":  cty.StringVal(remote.WorkspaceId),
+		"configuration": config,
+	})
+
+	return fwhelpers.PackModel(&state, nil)
+}
+
+func (r *connectorResource) create(data connectorData) (connectorData, error) {
+	if r.connector.Kind == destinationConnector {
+		destination, err := r.Client.CreateDestination(baseContext, destinationFromData(data))
+		return destinationToData(destination), err
+	}
+	source, err := r.Client.CreateSource(baseContext, sourceFromData(data))
+	return sourceToData(source), err
+}
+
+func (r *connectorResource) read(id string) (connectorData, error) {
+	if r.connector.Kind == destinationConnector {
+		destination, err := r.Client.ReadDestination(baseContext, id)
+		return destinationToData(destination), err
+	}
+	source, err := r.Client.ReadSource(baseContext, id)
+	return sourceToData(source), err
+}
+
+func (r *connectorResource) update(data connectorData) (connectorData, error) {
+	if r.connector.Kind == destinationConnector {
+		destination, err := r.Client.UpdateDestination(baseContext, destinationFromData(data))
+		return destinationToData(destination), err
+	}
+	source, err := r.Client.UpdateSource(baseContext, sourceFromData(data))
+	return sourceToData(source), err
+}
+
+func (r *connectorResource) delete(id string) error {
+	if r.connector.Kind == destinationConnector {
+		return r.Client.DeleteDestination(baseContext, id)
+	}
+	return r.Client.DeleteSource(baseContext, id)
+}
+
+func sourceFromData(data connectorData) api.Source {
+	return api.Source{
+		Name:          data.Name,
+		SourceId:      data.Id,
+		WorkspaceId:   data.WorkspaceId,
+		Configuration: data.Configuration,
+	}
+}
+
+func sourceToData(source api.Source) connectorData {
+	return connectorData{
+		Id:            source.SourceId,
+		Name:          source.Name,
+		WorkspaceId:   source.WorkspaceId,
+		Type:          source.SourceType,
+		Configuration: source.Configuration,
+	}
+}
+
+func destinationFromData(data connectorData) api.Destination {
+	return api.Destination{
+		Name:          data.Name,
+		DestinationId: data.Id,
+		WorkspaceId:   data.WorkspaceId,
+		Configuration: data.Configuration,
+	}
+}
+
+func destinationToData(destination api.Destination) connectorData {
+	return connectorData{
+		Id:            destination.DestinationId,
+		Name:          destination.Name,
+		WorkspaceId:   destination.WorkspaceId,
+		Type:          destination.DestinationType,
+		Configuration: destination.Configuration,
+	}
+}
+
+// Helper function to define the schema attributes of the fields.
+func connectorSchemaAttributes(fields []connectorField) map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{}
+	for _, f := range fields {
+		if f.Const != "" {
+			continue
+		}
+		attrs[f.Name] = connectorSchemaAttribute(f, false)
+	}
+	return attrs
+}
+
+func connectorSchemaAttribute(f connectorField, alternative bool) schema.Attribute {
+	// As with the other resources, optional attributes are both
+	// required and optional, defaulting to their zero value.
+	// Alternatives of a oneOf block are left unset instead.
+	required := !alternative
+	optional := f.Optional || alternative
+
+	description := f.Description
+	if len(f.Enum) > 0 {
+		description += ". One of " + joinAlternatives(f.Enum)
+	}
+	if f.Default != nil {
+		description += fmt.Sprintf(". Defaults to %v", f.Default)
+	}
+
+	switch f.Type {
+	case intField:
+		return &schema.IntAttribute{
+			Description: description,
+			Required:    required,
+			Optional:    optional,
+		}
+	case floatField:
+		return &schema.FloatAttribute{
+			Description: description,
+			Required:    required,
+			Optional:    optional,
+		}
+	case boolField:
+		return &schema.BoolAttribute{
+			Description: description,
+			Required:    required,
+			Optional:    optional,
+		}
+	case blockField:
+		block := &schema.MapAttribute{
+			Description: description,
+			Required:    required,
+			Optional:    optional,
+			Attributes:  map[string]schema.Attribute{},
+		}
+		for _, nested := range f.Fields {
+			if nested.Const != "" {
+				continue
+			}
+			block.Attributes[nested.Name] = connectorSchemaAttribute(nested, f.OneOf)
+			if f.OneOf {
+				block.ExactlyOneOf = append(block.ExactlyOneOf, nested.Name)
+			}
+		}
+		return block
+	default:
+		return &schema.StringAttribute{
+			Description: description,
+			Required:    required,
+			Optional:    optional,
+			Sensitive:   f.Sensitive,
+		}
+	}
+}
+
+// Helper function to convert a configuration object of the plan into
+// the Airbyte configuration, leaving out the unset optional fields.
+// The path prefixes the attribute names in errors.
+func connectorConfigToAPI(fields []connectorField, obj cty.Value, path string) (map[string]any, error) {
+	config := map[string]any{}
+	for _, f := range fields {
+		if f.Const != "" {
+			config[f.Name] = f.Const
+			continue
+		}
+
+		v := ctyAttr(obj, f.Name)
+		if f.Type == blockField {
+			if v.IsNull() && f.Optional {
+				continue
+			}
+
+			var value map[string]any
+			var err error
+			if f.OneOf {
+				value, err = connectorAlternativeToAPI(f, v, path+f.Name+".")
+			} else {
+				value, err = connectorConfigToAPI(f.Fields, v, path+f.Name+".")
+			}
+			if err != nil {
+				return nil, err
+			}
+			config[f.Name] = value
+			continue
+		}
+
+		value := ctyScalar(v, f.Type)
+		if f.Optional && value == zeroValue(f.Type) {
+			continue
+		}
+		if len(f.Enum) > 0 && !isEnumValue(f.Enum, value) {
+			return nil, fmt.Errorf("%s%s: %q should be one of %s", path, f.Name, value, joinAlternatives(f.Enum))
+		}
+		config[f.Name] = value
+	}
+	return config, nil
+}
+
+// Helper function to convert the alternative set in a oneOf block.
+func connectorAlternativeToAPI(f connectorField, obj cty.Value, path string) (map[string]any, error) {
+	names := []string{}
+	for _, alternative := range f.Fields {
+		v := ctyAttr(obj, alternative.Name)
+		if !v.IsNull() {
+			return connectorConfigToAPI(alternative.Fields, v, path+alternative.Name+".")
+		}
+		names = append(names, alternative.Name)
+	}
+	return nil, fmt.Errorf("%s: exactly one of %s should be set", strings.TrimSuffix(path, "."), joinAlternatives(names))
+}
+
+// Helper function to convert an Airbyte configuration into a
+// configuration object, retaining the current secrets when they
+// are masked in the configuration.
+func connectorConfigFromAPI(fields []connectorField, config map[string]any, current cty.Value) map[string]cty.Value {
+	obj := map[string]cty.Value{}
+	for _, f := range fields {
+		if f.Const != "" {
+			continue
+		}
+
+		currentValue := ctyAttr(current, f.Name)
+		remote, ok := config[f.Name]
+
+		// Defaults applied by Airbyte are left unset as configured.
+		if ok && f.Default != nil && fmt.Sprint(remote) == fmt.Sprint(f.Default) &&
+			ctyScalar(currentValue, f.Type) == zeroValue(f.Type) {
+			remote = nil
+		}
+
+		switch f.Type {
+		case stringField:
+			s, _ := remote.(string)
+			if f.Sensitive {
+				s = reconcileSecret(s, ctyString(currentValue))
+			}
+			obj[f.Name] = cty.StringVal(s)
+		case intField:
+			n, _ := remote.(float64)
+			obj[f.Name] = cty.NumberIntVal(int64(n))
+		case floatField:
+			n, _ := remote.(float64)
+			obj[f.Name] = cty.NumberFloatVal(n)
+		case boolField:
+			b, _ := remote.(bool)
+			obj[f.Name] = cty.BoolVal(b)
+		case blockField:
+			nested, _ := remote.(map[string]any)
+			if f.OneOf {
+				obj[f.Name] = connectorAlternativeFromAPI(f, nested, currentValue)
+			} else if nested == nil && f.Optional && currentValue.IsNull() {
+				obj[f.Name] = cty.NullVal(connectorConfigType(f.Fields))
+			} else {
+				obj[f.Name] = cty.ObjectVal(connectorConfigFromAPI(f.Fields, nested, currentValue))
+			}
+		}
+	}
+	return obj
+}
+
+// Helper function to convert the configuration of a oneOf block,
+// setting the alternative identified by its constant fields, or
+// else the one currently set.
+func connectorAlternativeFromAPI(f connectorField, config map[string]any, current cty.Value) cty.Value {
+	chosen := ""
+	for _, alternative := range f.Fields {
+		for _, nested := range alternative.Fields {
+			if nested.Const != "" && config[nested.Name] == nested.Const {
+				chosen = alternative.Name
+			}
+		}
+	}
+	if chosen == "" {
+		for _, alternative := range f.Fields {
+			if !ctyAttr(current, alternative.Name).IsNull() {
+				chosen = alternative.Name
+				break
+			}
+		}
+	}
+
+	obj := map[string]cty.Value{}
+	for _, alternative := range f.Fields {
+		if alternative.Name == chosen && config != nil {
+			obj[alternative.Name] = cty.ObjectVal(connectorConfigFromAPI(alternative.Fields, config, ctyAttr(current, alternative.Name)))
+		} else {
+			obj[alternative.Name] = cty.NullVal(connectorConfigType(alternative.Fields))
+		}
+	}
+	return cty.ObjectVal(obj)
+}
+
+// Helper function to get the type of a configuration object,
+// needed for the blocks left unset.
+func connectorConfigType(fields []connectorField) cty.Type {
+	attrs := map[string]cty.Type{}
+	for _, f := range fields {
+		if f.Const != "" {
+			continue
+		}
+		switch f.Type {
+		case stringField:
+			attrs[f.Name] = cty.String
+		case intField, floatField:
+			attrs[f.Name] = cty.Number
+		case boolField:
+			attrs[f.Name] = cty.Bool
+		case blockField:
+			attrs[f.Name] = connectorConfigType(f.Fields)
+		}
+	}
+	return cty.Object(attrs)
+}
+
+func isEnumValue(enum []string, value any) bool {
+	for _, e := range enum {
+		if value == e {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper function to join the values as "a, b or c".
+func joinAlternatives(values []string) string {
+	if len(values) < 2 {
+		return strings.Join(values, "")
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
+}
+
+func zeroValue(t fieldType) any {
+	switch t {
+	case stringField:
+		return ""
+	case intField:
+		return int64(0)
+	case floatField:
+		return float64(0)
+	case boolField:
+		return false
+	}
+	return nil
+}
+
+// Helper function to get an attribute of an object,
+// which is null if the object is null or lacks it.
+func ctyAttr(obj cty.Value, name string) cty.Value {
+	if obj.IsNull() || !obj.IsKnown() || !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return obj.GetAttr(name)
+}
+
+// Helper function to get the Go value of a scalar field.
+func ctyScalar(v cty.Value, t fieldType) any {
+	switch t {
+	case intField:
+		return ctyInt(v)
+	case floatField:
+		return ctyFloat(v)
+	case boolField:
+		return ctyBool(v)
+	}
+	return ctyString(v)
+}
+
+func ctyString(v cty.Value) string {
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.String {
+		return ""
+	}
+	return v.AsString()
+}
+
+func ctyInt(v cty.Value) int64 {
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.Number {
+		return 0
+	}
+	n, _ := v.AsBigFloat().Int64()
+	return n
+}
+
+func ctyFloat(v cty.Value) float64 {
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.Number {
+		return 0
+	}
+	n, _ := v.AsBigFloat().Float64()
+	return n
+}
+
+func ctyBool(v cty.Value) bool {
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.Bool {
+		return false
+	}
+	return v.True()
+}
@@ -0,0 +1,16 @@
+// Code generated by connectorgen from ../specs/source-stripe.json. DO NOT EDIT.
+
+package plugin
+
+var sourceStripeConnector = connector{
+	Kind:        sourceConnector,
+	Type:        "stripe",
+	Description: "Source Stripe resource for Airbyte",
+	Fields: []connectorField{
+		{Name: "account_id", Type: stringField, Description: "Account ID"},
+		{Name: "client_secret", Type: stringField, Description: "Secret Key", Sensitive: true},
+		{Name: "start_date", Type: stringField, Description: "Replication start date"},
+		{Name: "lookback_window_days", Type: intField, Description: "Lookback Window in days", Optional: true, Default: 0},
+		{Name: "slice_range", Type: intField, Description: "Data request time increment in days", Optional: true, Default: 365},
+	},
+}
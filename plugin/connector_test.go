@@ -0,0 +1,162 @@
+package plugin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zipstack/pct-plugin-framework/schema"
+)
+
+// Fields as generated by connectorgen for oneOf, enum, default and const.
+var testConnectorFields = []connectorField{
+	{Name: "region", Type: stringField, Description: "Region", Enum: []string{"us", "eu"}, Default: "us"},
+	{Name: "credentials", Type: blockField, Description: "Authentication", OneOf: true, Fields: []connectorField{
+		{Name: "oauth2_0", Type: blockField, Description: "OAuth2.0", Fields: []connectorField{
+			{Name: "auth_type", Type: stringField, Description: "Auth type", Const: "oauth2.0"},
+			{Name: "client_id", Type: stringField, Description: "Client ID"},
+		}},
+		{Name: "api_token", Type: blockField, Description: "Api token", Fields: []connectorField{
+			{Name: "api_token", Type: stringField, Description: "API Token", Sensitive: true},
+			{Name: "auth_type", Type: stringField, Description: "Auth type", Const: "api_token"},
+		}},
+	}},
+	{Name: "page_size", Type: intField, Description: "Page Size", Optional: true, Default: 100},
+}
+
+func testConnectorConfig(region string, credentials cty.Value, pageSize int64) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"region":      cty.StringVal(region),
+		"credentials": credentials,
+		"page_size":   cty.NumberIntVal(pageSize),
+	})
+}
+
+func testCredentials(oauth cty.Value, apiToken cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"oauth2_0":  oauth,
+		"api_token": apiToken,
+	})
+}
+
+var (
+	testOAuthType    = connectorConfigType(testConnectorFields[1].Fields[0].Fields)
+	testAPITokenType = connectorConfigType(testConnectorFields[1].Fields[1].Fields)
+)
+
+func TestConnectorSchemaAttributes(t *testing.T) {
+	attrs := connectorSchemaAttributes(testConnectorFields)
+
+	region := attrs["region"].(*schema.StringAttribute)
+	if region.Description != "Region. One of us or eu. Defaults to us" || !region.Required || region.Optional {
+		t.Errorf("region = %+v", region)
+	}
+
+	credentials := attrs["credentials"].(*schema.MapAttribute)
+	if !reflect.DeepEqual(credentials.ExactlyOneOf, []string{"oauth2_0", "api_token"}) {
+		t.Errorf("credentials.ExactlyOneOf = %v", credentials.ExactlyOneOf)
+	}
+
+	oauth := credentials.Attributes["oauth2_0"].(*schema.MapAttribute)
+	if oauth.Required || !oauth.Optional {
+		t.Errorf("credentials.oauth2_0 should be optional only, got %+v", oauth)
+	}
+	if _, ok := oauth.Attributes["auth_type"]; ok {
+		t.Errorf("credentials.oauth2_0.auth_type constant should not be an attribute")
+	}
+	apiToken := credentials.Attributes["api_token"].(*schema.MapAttribute).Attributes["api_token"].(*schema.StringAttribute)
+	if !apiToken.Sensitive {
+		t.Errorf("credentials.api_token.api_token should be sensitive")
+	}
+
+	pageSize := attrs["page_size"].(*schema.IntAttribute)
+	if pageSize.Description != "Page Size. Defaults to 100" || !pageSize.Required || !pageSize.Optional {
+		t.Errorf("page_size = %+v", pageSize)
+	}
+}
+
+func TestConnectorConfigToAPI(t *testing.T) {
+	oauth := cty.ObjectVal(map[string]cty.Value{"client_id": cty.StringVal("id")})
+	apiToken := cty.ObjectVal(map[string]cty.Value{"api_token": cty.StringVal("token")})
+
+	tests := []struct {
+		name    string
+		config  cty.Value
+		want    map[string]any
+		wantErr string
+	}{
+		{
+			name:   "first alternative",
+			config: testConnectorConfig("eu", testCredentials(oauth, cty.NullVal(testAPITokenType)), 0),
+			want: map[string]any{
+				"region":      "eu",
+				"credentials": map[string]any{"auth_type": "oauth2.0", "client_id": "id"},
+			},
+		},
+		{
+			name:   "second alternative",
+			config: testConnectorConfig("us", testCredentials(cty.NullVal(testOAuthType), apiToken), 50),
+			want: map[string]any{
+				"region":      "us",
+				"credentials": map[string]any{"auth_type": "api_token", "api_token": "token"},
+				"page_size":   int64(50),
+			},
+		},
+		{
+			name:    "no alternative",
+			config:  testConnectorConfig("us", testCredentials(cty.NullVal(testOAuthType), cty.NullVal(testAPITokenType)), 0),
+			wantErr: "configuration.credentials: exactly one of oauth2_0 or api_token should be set",
+		},
+		{
+			name:    "invalid enum",
+			config:  testConnectorConfig("ap", testCredentials(oauth, cty.NullVal(testAPITokenType)), 0),
+			wantErr: `configuration.region: "ap" should be one of us or eu`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := connectorConfigToAPI(testConnectorFields, tt.config, "configuration.")
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestConnectorConfigFromAPI(t *testing.T) {
+	current := testConnectorConfig("us", testCredentials(cty.NullVal(testOAuthType), cty.ObjectVal(map[string]cty.Value{
+		"api_token": cty.StringVal("token"),
+	})), 0)
+
+	// Alternative picked by its constant, secret retained and
+	// default applied by Airbyte left unset.
+	remote := map[string]any{
+		"region":      "us",
+		"credentials": map[string]any{"auth_type": "api_token", "api_token": "**********"},
+		"page_size":   float64(100),
+	}
+	got := cty.ObjectVal(connectorConfigFromAPI(testConnectorFields, remote, current))
+	if !got.RawEquals(current) {
+		t.Errorf("got %#v, want %#v", got, current)
+	}
+
+	// Alternative changed outside of PCT.
+	remote["credentials"] = map[string]any{"auth_type": "oauth2.0", "client_id": "id"}
+	got = cty.ObjectVal(connectorConfigFromAPI(testConnectorFields, remote, current))
+	want := testConnectorConfig("us", testCredentials(cty.ObjectVal(map[string]cty.Value{
+		"client_id": cty.StringVal("id"),
+	}), cty.NullVal(testAPITokenType)), 0)
+	if !got.RawEquals(want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
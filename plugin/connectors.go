@@ -0,0 +1,163 @@
+package plugin
+
+//go:generate go run ../cmd/connectorgen -kind source -type stripe -spec ../specs/source-stripe.json -plugin connector_source_stripe.go
+
+// Registry of the source and destination connectors. Connectors
+// with a specification under specs are generated by connectorgen.
+var connectors = []connector{
+	// Source connectors
+	{
+		Kind:        sourceConnector,
+		Type:        "pipedrive",
+		Description: "Source Pipedrive resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "replication_start_date", Type: stringField, Description: "Replication Start Date"},
+			{Name: "authorization", Type: blockField, Description: "authorization", Fields: []connectorField{
+				{Name: "auth_type", Type: stringField, Description: "Auth Type"},
+				{Name: "api_token", Type: stringField, Description: "API Token", Sensitive: true},
+			}},
+		},
+	},
+	sourceStripeConnector,
+	{
+		Kind:        sourceConnector,
+		Type:        "amplitude",
+		Description: "Source Amplitude resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "start_date", Type: stringField, Description: "Start Date"},
+			{Name: "data_region", Type: stringField, Description: "Date Region", Optional: true},
+			{Name: "request_time_range", Type: intField, Description: "Required time range", Optional: true},
+			{Name: "secret_key", Type: stringField, Description: "Secret Key", Sensitive: true},
+			{Name: "api_key", Type: stringField, Description: "Api Key", Sensitive: true},
+		},
+	},
+	{
+		Kind:        sourceConnector,
+		Type:        "shopify",
+		Description: "Source Shopify resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "start_date", Type: stringField, Description: "Start Date"},
+			{Name: "shop", Type: stringField, Description: "Shop"},
+			{Name: "credentials", Type: blockField, Description: "credentials", Fields: []connectorField{
+				{Name: "auth_method", Type: stringField, Description: "auth_method"},
+				{Name: "api_password", Type: stringField, Description: "api_password", Sensitive: true},
+			}},
+		},
+	},
+	{
+		Kind:        sourceConnector,
+		Type:        "freshdesk",
+		Description: "Source Freshdesk resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "start_date", Type: stringField, Description: "Start Date"},
+			{Name: "domain", Type: stringField, Description: "Domain"},
+			{Name: "api_key", Type: stringField, Description: "Api Key", Sensitive: true},
+			{Name: "requests_per_minute", Type: intField, Description: "Requests Per Minute", Optional: true},
+		},
+	},
+	{
+		Kind:        sourceConnector,
+		Type:        "zendesk-support",
+		Description: "Source ZendeskSupport resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "start_date", Type: stringField, Description: "Start Date"},
+			{Name: "ignore_pagination", Type: boolField, Description: "Ignore Pagination", Optional: true},
+			{Name: "subdomain", Type: stringField, Description: "SubDomain"},
+			{Name: "credentials", Type: blockField, Description: "Credentials", Fields: []connectorField{
+				{Name: "credentials", Type: stringField, Description: "credentials"},
+				{Name: "email", Type: stringField, Description: "Email"},
+				{Name: "api_token", Type: stringField, Description: "Api Token", Sensitive: true},
+			}},
+		},
+	},
+	{
+		Kind:        sourceConnector,
+		Type:        "hubspot",
+		Description: "Source Hubspot resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "start_date", Type: stringField, Description: "Start Date"},
+			{Name: "credentials", Type: blockField, Description: "credentials", Fields: []connectorField{
+				{Name: "credentials_title", Type: stringField, Description: "credentials title"},
+				{Name: "access_token", Type: stringField, Description: "Access Token", Sensitive: true},
+			}},
+		},
+	},
+	{
+		Kind:        sourceConnector,
+		Type:        "google-analytics-v4",
+		Description: "Source GoogleAnalyticsV4 resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "start_date", Type: stringField, Description: "Start Date"},
+			{Name: "view_id", Type: stringField, Description: "View Id"},
+			{Name: "window_in_days", Type: intField, Description: "window in days", Optional: true},
+			{Name: "custom_reports", Type: stringField, Description: "custom reports", Optional: true},
+			{Name: "credentials", Type: blockField, Description: "credentials", Fields: []connectorField{
+				{Name: "auth_type", Type: stringField, Description: "Auth Type"},
+				{Name: "credentials_json", Type: stringField, Description: "Credential Json", Sensitive: true},
+			}},
+		},
+	},
+	{
+		Kind:        sourceConnector,
+		Type:        "google-sheets",
+		Description: "Source GoogleSheets resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "row_batch_size", Type: intField, Description: "Row Batch Size", Optional: true},
+			{Name: "spreadsheet_id", Type: stringField, Description: "Spreadsheet Id"},
+			{Name: "credentials", Type: blockField, Description: "credentials", Fields: []connectorField{
+				{Name: "auth_type", Type: stringField, Description: "Auth Type"},
+				{Name: "service_account_info", Type: stringField, Description: "Service Account Info", Sensitive: true},
+			}},
+		},
+	},
+	{
+		Kind:        sourceConnector,
+		Type:        "facebook-marketing",
+		Description: "Source FacebookMarketing resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "account_id", Type: stringField, Description: "account id"},
+			{Name: "start_date", Type: stringField, Description: "start date"},
+			{Name: "access_token", Type: stringField, Description: "Access Token", Sensitive: true},
+			{Name: "end_date", Type: stringField, Description: "end date", Optional: true},
+			{Name: "include_deleted", Type: boolField, Description: "Include Deleted", Optional: true},
+			{Name: "fetch_thumbnail_images", Type: boolField, Description: "Fetch Thumbnail Image", Optional: true},
+			{Name: "page_size", Type: intField, Description: "Page Size", Optional: true},
+			{Name: "insights_lookback_window", Type: intField, Description: "insights_lookback_window", Optional: true},
+			{Name: "max_batch_size", Type: intField, Description: "Max Batch Size", Optional: true},
+			{Name: "action_breakdowns_allow_empty", Type: boolField, Description: "Action Breakdowns Allow Empty", Optional: true},
+		},
+	},
+
+	// Destination connectors
+	{
+		Kind:        destinationConnector,
+		Type:        "mysql",
+		Description: "Destination mysql resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "port", Type: intField, Description: "Port"},
+			{Name: "host", Type: stringField, Description: "Host"},
+			{Name: "username", Type: stringField, Description: "Username"},
+			{Name: "password", Type: stringField, Description: "Password", Sensitive: true},
+			{Name: "database", Type: stringField, Description: "Database"},
+		},
+	},
+	{
+		Kind:        destinationConnector,
+		Type:        "postgres",
+		Description: "Destination Postgres resource for Airbyte",
+		Fields: []connectorField{
+			{Name: "port", Type: intField, Description: "Port"},
+			{Name: "host", Type: stringField, Description: "Host"},
+			{Name: "username", Type: stringField, Description: "Username"},
+			{Name: "password", Type: stringField, Description: "Password", Sensitive: true},
+			{Name: "database", Type: stringField, Description: "Database"},
+			{Name: "schema", Type: stringField, Description: "Schema"},
+			{Name: "ssl_mode", Type: blockField, Description: "ssl mode", Fields: []connectorField{
+				{Name: "mode", Type: stringField, Description: "mode"},
+			}},
+			{Name: "tunnel_method", Type: blockField, Description: "Tunnel Method", Fields: []connectorField{
+				{Name: "tunnel_method", Type: stringField, Description: "tunnel method"},
+			}},
+		},
+	},
+}
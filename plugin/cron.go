@@ -0,0 +1,152 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Quartz cron field bounds and accepted names, in field order.
+var quartzCronFields = []struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}{
+	{name: "seconds", min: 0, max: 59},
+	{name: "minutes", min: 0, max: 59},
+	{name: "hours", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day-of-week", min: 1, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+	{name: "year", min: 1970, max: 2099},
+}
+
+const (
+	quartzDayOfMonth = 3
+	quartzDayOfWeek  = 5
+)
+
+// Helper function to validate a Quartz cron expression as accepted by
+// Airbyte, i.e. seconds, minutes, hours, day-of-month, month, day-of-week
+// and an optional year, followed by an optional time zone.
+func validateQuartzCron(expr string) error {
+	fields := strings.Fields(expr)
+
+	// Trailing time zone, e.g. UTC or America/New_York. The year
+	// field is numeric so it cannot be mistaken for one.
+	if len(fields) >= 7 && unicode.IsLetter(rune(fields[len(fields)-1][0])) {
+		fields = fields[:len(fields)-1]
+	}
+
+	if len(fields) == 5 {
+		return fmt.Errorf("%q looks like a Unix cron expression, Airbyte expects Quartz syntax "+
+			"with a leading seconds field, e.g. \"0 %s\"", expr, strings.Join(fields[:4], " ")+" ?")
+	}
+	if len(fields) < 6 || len(fields) > 7 {
+		return fmt.Errorf("%q should have 6 or 7 fields, found %d", expr, len(fields))
+	}
+
+	for i, field := range fields {
+		err := validateQuartzCronField(i, field)
+		if err != nil {
+			return fmt.Errorf("%q has invalid %s field: %s", expr, quartzCronFields[i].name, err)
+		}
+	}
+
+	// Quartz requires exactly one of day-of-month and day-of-week to be unspecified.
+	if (fields[quartzDayOfMonth] == "?") == (fields[quartzDayOfWeek] == "?") {
+		return fmt.Errorf("%q should have '?' in exactly one of the day-of-month and day-of-week fields", expr)
+	}
+
+	return nil
+}
+
+func validateQuartzCronField(index int, field string) error {
+	if field == "?" {
+		if index != quartzDayOfMonth && index != quartzDayOfWeek {
+			return fmt.Errorf("'?' is only allowed for day-of-month and day-of-week")
+		}
+		return nil
+	}
+
+	for _, item := range strings.Split(field, ",") {
+		err := validateQuartzCronItem(index, item)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateQuartzCronItem(index int, item string) error {
+	spec := quartzCronFields[index]
+	upper := strings.ToUpper(item)
+
+	// Special values of the day fields.
+	switch index {
+	case quartzDayOfMonth:
+		if upper == "L" || upper == "LW" {
+			return nil
+		}
+		if strings.HasPrefix(upper, "L-") {
+			return parseQuartzValue(index, upper[2:], 0, 30)
+		}
+		if strings.HasSuffix(upper, "W") {
+			return parseQuartzValue(index, upper[:len(upper)-1], spec.min, spec.max)
+		}
+	case quartzDayOfWeek:
+		if upper == "L" {
+			return nil
+		}
+		if strings.HasSuffix(upper, "L") {
+			return parseQuartzValue(index, upper[:len(upper)-1], spec.min, spec.max)
+		}
+		if parts := strings.SplitN(upper, "#", 2); len(parts) == 2 {
+			err := parseQuartzValue(index, parts[0], spec.min, spec.max)
+			if err != nil {
+				return err
+			}
+			return parseQuartzValue(index, parts[1], 1, 5)
+		}
+	}
+
+	rangePart := upper
+	if parts := strings.SplitN(upper, "/", 2); len(parts) == 2 {
+		rangePart = parts[0]
+		step, err := strconv.Atoi(parts[1])
+		if err != nil || step < 1 {
+			return fmt.Errorf("invalid step %q", parts[1])
+		}
+	}
+
+	if rangePart == "*" {
+		return nil
+	}
+	if parts := strings.SplitN(rangePart, "-", 2); len(parts) == 2 {
+		err := parseQuartzValue(index, parts[0], spec.min, spec.max)
+		if err != nil {
+			return err
+		}
+		return parseQuartzValue(index, parts[1], spec.min, spec.max)
+	}
+	return parseQuartzValue(index, rangePart, spec.min, spec.max)
+}
+
+func parseQuartzValue(index int, value string, min int, max int) error {
+	for _, name := range quartzCronFields[index].names {
+		if value == name {
+			return nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("invalid value %q", value)
+	}
+	if n < min || n > max {
+		return fmt.Errorf("value %d out of range %d-%d", n, min, max)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateQuartzCron(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		// Valid expressions
+		{expr: "0 0 12 * * ?"},
+		{expr: "0 15 10 ? * *"},
+		{expr: "0 0/5 14,18 * * ?"},
+		{expr: "0 0-5 14 * * ?"},
+		{expr: "0 15 10 ? * MON-FRI"},
+		{expr: "0 15 10 ? * mon-fri"},
+		{expr: "0 0 12 ? JAN,MAR SUN"},
+		{expr: "0 15 10 L * ?"},
+		{expr: "0 15 10 LW * ?"},
+		{expr: "0 15 10 L-2 * ?"},
+		{expr: "0 15 10 15W * ?"},
+		{expr: "0 15 10 ? * 6L"},
+		{expr: "0 15 10 ? * L"},
+		{expr: "0 15 10 ? * 6#3"},
+		{expr: "0 15 10 * * ? 2030"},
+		{expr: "0 15 10 * * ? 2030-2035"},
+		{expr: "0 0 12 * * ? UTC"},
+		{expr: "0 0 12 * * ? America/New_York"},
+		{expr: "0 0 12 * * ? 2030 UTC"},
+		{expr: "*/30 * * * * ?"},
+
+		// Field count
+		{expr: "0 12 * * *", wantErr: "looks like a Unix cron expression"},
+		{expr: "0 0 12 *", wantErr: "should have 6 or 7 fields, found 4"},
+		{expr: "0 0 12 * * ? 2030 2031", wantErr: "should have 6 or 7 fields, found 8"},
+		{expr: "", wantErr: "should have 6 or 7 fields, found 0"},
+
+		// Ranges and values
+		{expr: "60 0 12 * * ?", wantErr: "invalid seconds field: value 60 out of range 0-59"},
+		{expr: "0 60 12 * * ?", wantErr: "invalid minutes field"},
+		{expr: "0 0 24 * * ?", wantErr: "invalid hours field"},
+		{expr: "0 0 12 0 * ?", wantErr: "invalid day-of-month field"},
+		{expr: "0 0 12 32 * ?", wantErr: "invalid day-of-month field"},
+		{expr: "0 0 12 * 13 ?", wantErr: "invalid month field"},
+		{expr: "0 0 12 * FOO ?", wantErr: "invalid month field: invalid value \"FOO\""},
+		{expr: "0 0 12 ? * 8", wantErr: "invalid day-of-week field"},
+		{expr: "0 0 12 * * ? 1969", wantErr: "invalid year field"},
+		{expr: "0 0/0 12 * * ?", wantErr: "invalid step \"0\""},
+		{expr: "0 0/x 12 * * ?", wantErr: "invalid step \"X\""},
+		{expr: "0 0-x 12 * * ?", wantErr: "invalid value \"X\""},
+
+		// Special characters
+		{expr: "0 0 12 L-31 * ?", wantErr: "value 31 out of range 0-30"},
+		{expr: "0 0 12 32W * ?", wantErr: "invalid day-of-month field"},
+		{expr: "0 0 12 ? * 6#6", wantErr: "value 6 out of range 1-5"},
+		{expr: "0 0 12 ? * 8#1", wantErr: "invalid day-of-week field"},
+		{expr: "0 0 12 ? * 8L", wantErr: "invalid day-of-week field"},
+		{expr: "? 0 12 * * ?", wantErr: "'?' is only allowed for day-of-month and day-of-week"},
+
+		// Exclusivity of day-of-month and day-of-week
+		{expr: "0 0 12 * * *", wantErr: "'?' in exactly one of the day-of-month and day-of-week fields"},
+		{expr: "0 0 12 ? * ?", wantErr: "'?' in exactly one of the day-of-month and day-of-week fields"},
+		{expr: "0 0 12 1 * MON", wantErr: "'?' in exactly one of the day-of-month and day-of-week fields"},
+	}
+
+	for _, tt := range tests {
+		err := validateQuartzCron(tt.expr)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("validateQuartzCron(%q) = %v, want no error", tt.expr, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("validateQuartzCron(%q) = %v, want error containing %q", tt.expr, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateQuartzCronUnixHint(t *testing.T) {
+	err := validateQuartzCron("30 2 * * 1")
+	want := `"30 2 * * 1" looks like a Unix cron expression, Airbyte expects Quartz syntax with a leading seconds field, e.g. "0 30 2 * * ?"`
+	if err == nil || err.Error() != want {
+		t.Errorf("validateQuartzCron() = %v, want %s", err, want)
+	}
+}
+
+func TestValidateConnSchedule(t *testing.T) {
+	tests := []struct {
+		schedule connScheduleData
+		wantErr  string
+	}{
+		{schedule: connScheduleData{ScheduleType: "manual"}},
+		{schedule: connScheduleData{ScheduleType: "basic"}},
+		{schedule: connScheduleData{ScheduleType: "cron", CronExpression: "0 0 12 * * ?"}},
+		{schedule: connScheduleData{ScheduleType: "cron"}, wantErr: "schedule.cron_expression: required for schedule_type cron"},
+		{schedule: connScheduleData{ScheduleType: "cron", CronExpression: "0 12 * * *"}, wantErr: "schedule.cron_expression: "},
+		{schedule: connScheduleData{ScheduleType: "manual", CronExpression: "0 0 12 * * ?"}, wantErr: "only allowed for schedule_type cron"},
+		{schedule: connScheduleData{ScheduleType: "hourly"}, wantErr: "schedule.schedule_type: should be one of manual, cron or basic"},
+	}
+
+	for _, tt := range tests {
+		err := validateConnSchedule(tt.schedule)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("validateConnSchedule(%+v) = %v, want no error", tt.schedule, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("validateConnSchedule(%+v) = %v, want error containing %q", tt.schedule, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package plugin
+
+import "fmt"
+
+// Helper function to verify that a resource imported by ID is of the
+// type served by the resource service.
+func checkResourceType(kind string, id string, expected string, actual string) error {
+	if actual != expected {
+		return fmt.Errorf("%s %s is of type %q, expected %q", kind, id, actual, expected)
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/zipstack/pct-plugin-framework/fwhelpers"
+	"github.com/zipstack/pct-plugin-framework/schema"
+
+	"github.com/zipstack/pct-provider-airbyte-cloud/api"
+)
+
+const (
+	// Wait for a job to finish, unless configured otherwise.
+	defaultJobWaitTimeout = time.Duration(1) * time.Hour
+
+	// Interval between the job status checks.
+	jobPollInterval = time.Duration(10) * time.Second
+)
+
+// Resource implementation.
+type jobResource struct {
+	Client *api.Client
+}
+
+type jobResourceModel struct {
+	ConnectionId string `pctsdk:"connection_id"`
+	JobType      string `pctsdk:"job_type"`
+	WaitTimeout  int    `pctsdk:"wait_timeout"`
+	JobId        int    `pctsdk:"job_id"`
+	Status       string `pctsdk:"status"`
+	StartTime    string `pctsdk:"start_time"`
+	Duration     string `pctsdk:"duration"`
+	BytesSynced  int    `pctsdk:"bytes_synced"`
+	RowsSynced   int    `pctsdk:"rows_synced"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ schema.ResourceService = &jobResource{}
+)
+
+// Helper function to return a resource service instance.
+func NewJobResource() schema.ResourceService {
+	return &jobResource{}
+}
+
+// Metadata returns the resource type name.
+// It is always provider name + "_" + resource type name.
+func (r *jobResource) Metadata(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{
+		TypeName: req.TypeName + "_job",
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jobResource) Configure(req *schema.ServiceRequest) *schema.ServiceResponse {
+	if req.ResourceData == "" {
+		return schema.ErrorResponse(fmt.Errorf("no data provided to configure resource"))
+	}
+
+	var creds map[string]string
+	err := fwhelpers.Decode(req.ResourceData, &creds)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	client, err := newClient(creds)
+	if err != nil {
+		return schema.ErrorResponse(fmt.Errorf("malformed data provided to configure resource"))
+	}
+
+	r.Client = client
+
+	return &schema.ServiceResponse{}
+}
+
+// Schema defines the schema for the resource.
+func (r *jobResource) Schema() *schema.ServiceResponse {
+	s := &schema.Schema{
+		Description: "Sync or reset job for an Airbyte connection, awaited until it finishes",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": &schema.StringAttribute{
+				Description: "Connection ID",
+				Required:    true,
+			},
+			"job_type": &schema.StringAttribute{
+				Description: "Job Type. One of sync or reset, defaults to sync",
+				Required:    true,
+				Optional:    true,
+			},
+			"wait_timeout": &schema.IntAttribute{
+				Description: "Seconds to wait for the job to finish. Defaults to 3600",
+				Required:    true,
+				Optional:    true,
+			},
+			"job_id": &schema.IntAttribute{
+				Description: "Job ID",
+				Computed:    true,
+			},
+			"status": &schema.StringAttribute{
+				Description: "Status",
+				Computed:    true,
+			},
+			"start_time": &schema.StringAttribute{
+				Description: "Start Time",
+				Computed:    true,
+			},
+			"duration": &schema.StringAttribute{
+				Description: "Duration in ISO 8601 format",
+				Computed:    true,
+			},
+			"bytes_synced": &schema.IntAttribute{
+				Description: "Bytes Synced",
+				Computed:    true,
+			},
+			"rows_synced": &schema.IntAttribute{
+				Description: "Rows Synced",
+				Computed:    true,
+			},
+		},
+	}
+
+	sEnc, err := fwhelpers.Encode(s)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		SchemaContents: sEnc,
+	}
+}
+
+// Create triggers a new job and waits for it to finish.
+func (r *jobResource) Create(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan jobResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return r.run(plan)
+}
+
+// Read resource information
+func (r *jobResource) Read(req *schema.ServiceRequest) *schema.ServiceResponse {
+	var state jobResourceModel
+
+	// Get current state
+	err := fwhelpers.UnpackModel(req.StateContents, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	res := schema.ServiceResponse{}
+
+	if req.StateID != "" {
+		jobId, err := strconv.Atoi(req.StateID)
+		if err != nil {
+			return schema.ErrorResponse(fmt.Errorf("invalid job id %q", req.StateID))
+		}
+
+		// Query using existing previous state.
+		job, err := r.Client.ReadJob(baseContext, jobId)
+		if api.IsNotFound(err) {
+			// Removed outside of PCT, empty StateID plans a re-create.
+			return &schema.ServiceResponse{
+				StateContents: req.StateContents,
+			}
+		}
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+
+		// Update state with refreshed value, job type as configured
+		state.ConnectionId = job.ConnectionId
+		state.JobId = job.JobId
+		state.Status = job.Status
+		state.StartTime = job.StartTime
+		state.Duration = job.Duration
+		state.BytesSynced = job.BytesSynced
+		state.RowsSynced = job.RowsSynced
+
+		res.StateID = strconv.Itoa(state.JobId)
+	} else {
+		// No previous state exists.
+		res.StateID = ""
+	}
+
+	// Set refreshed state
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	res.StateContents = stateEnc
+
+	return &res
+}
+
+// Update triggers a new job as finished jobs cannot be changed.
+func (r *jobResource) Update(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan jobResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return r.run(plan)
+}
+
+// Delete only removes the state, job history is retained by Airbyte.
+func (r *jobResource) Delete(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{}
+}
+
+func (r *jobResource) run(plan jobResourceModel) *schema.ServiceResponse {
+	// Generate API request body from plan
+	body := api.Job{}
+	body.ConnectionId = plan.ConnectionId
+	body.JobType = plan.JobType
+	if body.JobType == "" {
+		body.JobType = "sync"
+	}
+
+	waitTimeout := defaultJobWaitTimeout
+	if plan.WaitTimeout > 0 {
+		waitTimeout = time.Duration(plan.WaitTimeout) * time.Second
+	}
+
+	// Trigger new job
+	job, err := r.Client.CreateJob(baseContext, body)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	jobId := job.JobId
+
+	job, err = r.Client.WaitForJob(baseContext, jobId, jobPollInterval, waitTimeout)
+	if err != nil {
+		// Job is running, keep it in state so that it is not triggered again.
+		res := schema.ErrorResponse(err)
+		job.JobId = jobId
+		stateEnc, packErr := packJobState(plan, job)
+		if packErr == nil {
+			res.StateID = strconv.Itoa(jobId)
+			res.StateContents = stateEnc
+			res.StateLastUpdated = time.Now().Format(time.RFC850)
+		}
+		return res
+	}
+	if job.Status != "succeeded" {
+		return schema.ErrorResponse(fmt.Errorf("%s job %d finished with status %s", job.JobType, job.JobId, job.Status))
+	}
+
+	// Update resource state with response body
+	stateEnc, err := packJobState(plan, job)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          strconv.Itoa(job.JobId),
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
+
+// Helper function to pack the state of a job, with the
+// attributes not known to Airbyte retained from the plan.
+func packJobState(plan jobResourceModel, job api.Job) (string, error) {
+	state := jobResourceModel{}
+	state.ConnectionId = plan.ConnectionId
+	state.JobType = plan.JobType
+	state.WaitTimeout = plan.WaitTimeout
+	state.JobId = job.JobId
+	state.Status = job.Status
+	state.StartTime = job.StartTime
+	state.Duration = job.Duration
+	state.BytesSynced = job.BytesSynced
+	state.RowsSynced = job.RowsSynced
+
+	return fwhelpers.PackModel(nil, &state)
+}
@@ -0,0 +1,260 @@
+package plugin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zipstack/pct-plugin-framework/fwhelpers"
+	"github.com/zipstack/pct-plugin-framework/schema"
+
+	"github.com/zipstack/pct-provider-airbyte-cloud/api"
+)
+
+// Read-only resource implementation for the job history of a connection.
+type jobsResource struct {
+	Client *api.Client
+}
+
+type jobsResourceModel struct {
+	ConnectionId   string         `pctsdk:"connection_id"`
+	JobType        string         `pctsdk:"job_type"`
+	Status         string         `pctsdk:"status"`
+	CreatedAtStart string         `pctsdk:"created_at_start"`
+	CreatedAtEnd   string         `pctsdk:"created_at_end"`
+	Limit          int            `pctsdk:"limit"`
+	Jobs           []jobItemModel `pctsdk:"jobs"`
+}
+
+type jobItemModel struct {
+	JobId       int    `pctsdk:"job_id"`
+	JobType     string `pctsdk:"job_type"`
+	Status      string `pctsdk:"status"`
+	StartTime   string `pctsdk:"start_time"`
+	EndTime     string `pctsdk:"end_time"`
+	Duration    string `pctsdk:"duration"`
+	BytesSynced int    `pctsdk:"bytes_synced"`
+	RowsSynced  int    `pctsdk:"rows_synced"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ schema.ResourceService = &jobsResource{}
+)
+
+// Helper function to return a resource service instance.
+func NewJobsResource() schema.ResourceService {
+	return &jobsResource{}
+}
+
+// Metadata returns the resource type name.
+// It is always provider name + "_" + resource type name.
+func (r *jobsResource) Metadata(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{
+		TypeName: req.TypeName + "_jobs",
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jobsResource) Configure(req *schema.ServiceRequest) *schema.ServiceResponse {
+	if req.ResourceData == "" {
+		return schema.ErrorResponse(fmt.Errorf("no data provided to configure resource"))
+	}
+
+	var creds map[string]string
+	err := fwhelpers.Decode(req.ResourceData, &creds)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	client, err := newClient(creds)
+	if err != nil {
+		return schema.ErrorResponse(fmt.Errorf("malformed data provided to configure resource"))
+	}
+
+	r.Client = client
+
+	return &schema.ServiceResponse{}
+}
+
+// Schema defines the schema for the resource.
+func (r *jobsResource) Schema() *schema.ServiceResponse {
+	s := &schema.Schema{
+		Description: "Read-only job history of an Airbyte connection, most recent first",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": &schema.StringAttribute{
+				Description: "Connection ID",
+				Required:    true,
+			},
+			"job_type": &schema.StringAttribute{
+				Description: "Filter by Job Type. One of sync or reset",
+				Required:    true,
+				Optional:    true,
+			},
+			"status": &schema.StringAttribute{
+				Description: "Filter by Status. One of pending, running, incomplete, failed, succeeded or cancelled",
+				Required:    true,
+				Optional:    true,
+			},
+			"created_at_start": &schema.StringAttribute{
+				Description: "Filter jobs created at or after this time, in RFC 3339 format",
+				Required:    true,
+				Optional:    true,
+			},
+			"created_at_end": &schema.StringAttribute{
+				Description: "Filter jobs created at or before this time, in RFC 3339 format",
+				Required:    true,
+				Optional:    true,
+			},
+			"limit": &schema.IntAttribute{
+				Description: "Maximum number of jobs to return. All jobs are returned if not set",
+				Required:    true,
+				Optional:    true,
+			},
+			"jobs": &schema.ListAttribute{
+				Description: "Jobs",
+				Computed:    true,
+				NestedAttribute: &schema.MapAttribute{
+					Description: "Job",
+					Computed:    true,
+					Attributes: map[string]schema.Attribute{
+						"job_id": &schema.IntAttribute{
+							Description: "Job ID",
+							Computed:    true,
+						},
+						"job_type": &schema.StringAttribute{
+							Description: "Job Type",
+							Computed:    true,
+						},
+						"status": &schema.StringAttribute{
+							Description: "Status",
+							Computed:    true,
+						},
+						"start_time": &schema.StringAttribute{
+							Description: "Start Time",
+							Computed:    true,
+						},
+						"end_time": &schema.StringAttribute{
+							Description: "End Time, set once the job has finished",
+							Computed:    true,
+						},
+						"duration": &schema.StringAttribute{
+							Description: "Duration in ISO 8601 format",
+							Computed:    true,
+						},
+						"bytes_synced": &schema.IntAttribute{
+							Description: "Bytes Synced",
+							Computed:    true,
+						},
+						"rows_synced": &schema.IntAttribute{
+							Description: "Rows Synced",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	sEnc, err := fwhelpers.Encode(s)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		SchemaContents: sEnc,
+	}
+}
+
+// Create lists the jobs matching the filters.
+func (r *jobsResource) Create(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan jobsResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return r.list(plan)
+}
+
+// Read refreshes the listed jobs.
+func (r *jobsResource) Read(req *schema.ServiceRequest) *schema.ServiceResponse {
+	var state jobsResourceModel
+
+	// Get current state
+	err := fwhelpers.UnpackModel(req.StateContents, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	if req.StateID == "" {
+		// No previous state exists.
+		return &schema.ServiceResponse{
+			StateContents: req.StateContents,
+		}
+	}
+
+	return r.list(state)
+}
+
+// Update lists the jobs again with the changed filters.
+func (r *jobsResource) Update(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan jobsResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return r.list(plan)
+}
+
+// Delete only removes the state as nothing exists remotely.
+func (r *jobsResource) Delete(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{}
+}
+
+func (r *jobsResource) list(model jobsResourceModel) *schema.ServiceResponse {
+	filter := api.JobsFilter{}
+	filter.ConnectionId = model.ConnectionId
+	filter.JobType = model.JobType
+	filter.Status = model.Status
+	filter.CreatedAtStart = model.CreatedAtStart
+	filter.CreatedAtEnd = model.CreatedAtEnd
+	filter.Limit = model.Limit
+
+	jobs, err := r.Client.ListJobs(baseContext, filter)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state := model
+	state.Jobs = nil
+
+	for _, job := range jobs {
+		item := jobItemModel{}
+		item.JobId = job.JobId
+		item.JobType = job.JobType
+		item.Status = job.Status
+		item.StartTime = job.StartTime
+		item.Duration = job.Duration
+		item.BytesSynced = job.BytesSynced
+		item.RowsSynced = job.RowsSynced
+		if job.IsTerminal() {
+			item.EndTime = job.LastUpdatedAt
+		}
+		state.Jobs = append(state.Jobs, item)
+	}
+
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          state.ConnectionId,
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
@@ -1,7 +1,11 @@
 package plugin
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/zipstack/pct-plugin-framework/fwhelpers"
 	"github.com/zipstack/pct-plugin-framework/schema"
@@ -15,10 +19,32 @@ type Provider struct {
 	ResourceServices map[string]string
 }
 
-// Model maps the provider state as per schema.
+// Model maps the provider state as per schema. Attributes for which
+// zero is meaningful are pointers, nil when they are not configured.
 type ProviderModel struct {
-	Host          string `pctsdk:"host"`
-	Authorization string `pctsdk:"authorization"`
+	Host              string   `pctsdk:"host"`
+	Authorization     string   `pctsdk:"authorization"`
+	ClientID          string   `pctsdk:"client_id"`
+	ClientSecret      string   `pctsdk:"client_secret"`
+	MaxRetries        *int     `pctsdk:"max_retries,omitempty"`
+	RetryMaxWait      *int     `pctsdk:"retry_max_wait,omitempty"`
+	RequestsPerSecond *float64 `pctsdk:"requests_per_second,omitempty"`
+	Burst             *int     `pctsdk:"burst,omitempty"`
+	RequestTimeout    *int     `pctsdk:"request_timeout,omitempty"`
+	CreateTimeout     *int     `pctsdk:"create_timeout,omitempty"`
+	DiscoverTimeout   *int     `pctsdk:"discover_timeout,omitempty"`
+}
+
+// Airbyte Cloud public API used when no host is configured.
+const defaultHost = "https://api.airbyte.com"
+
+// Context of all the API requests made by the provider and resources.
+var baseContext = context.Background()
+
+// SetBaseContext sets the context from which all the API requests
+// derive, so that cancelling it aborts the requests in flight.
+func SetBaseContext(ctx context.Context) {
+	baseContext = ctx
 }
 
 // Ensure the implementation satisfies the expected interfaces
@@ -42,16 +68,59 @@ func (p *Provider) Metadata(req *schema.ServiceRequest) *schema.ServiceResponse
 func (p *Provider) Schema() *schema.ServiceResponse {
 	s := &schema.Schema{
 		Description: "Airbyte provider plugin",
+		// Numeric attributes are only optional, as zero is a valid
+		// setting distinct from leaving them unset.
 		Attributes: map[string]schema.Attribute{
 			"host": &schema.StringAttribute{
-				Description: "URI for Airbyte API. May also be provided via AIRBYTE_HOST environment variable.",
+				Description: "URI for Airbyte API. May also be provided via AIRBYTE_HOST environment variable. Defaults to " + defaultHost + ".",
 				Required:    true,
+				Optional:    true,
 			},
 			"authorization": &schema.StringAttribute{
-				Description: "Bearer Token for airbyte provider",
+				Description: "Bearer Token for airbyte provider. Either this or client_id and client_secret are required. May also be provided via AIRBYTE_TOKEN environment variable.",
+				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"client_id": &schema.StringAttribute{
+				Description: "Client ID of the Airbyte application, exchanged for an access token along with client_secret. May also be provided via AIRBYTE_CLIENT_ID environment variable.",
+				Required:    true,
+				Optional:    true,
+			},
+			"client_secret": &schema.StringAttribute{
+				Description: "Client Secret of the Airbyte application. May also be provided via AIRBYTE_CLIENT_SECRET environment variable.",
 				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 			},
+			"max_retries": &schema.IntAttribute{
+				Description: "Maximum number of retries for throttled or failed requests. Defaults to 4, 0 disables retries.",
+				Optional:    true,
+			},
+			"retry_max_wait": &schema.IntAttribute{
+				Description: "Maximum wait in seconds between retries. Defaults to 30.",
+				Optional:    true,
+			},
+			"requests_per_second": &schema.FloatAttribute{
+				Description: "Requests per second allowed for all resources sharing the host and credentials. Defaults to 2, 0 disables the rate limit.",
+				Optional:    true,
+			},
+			"burst": &schema.IntAttribute{
+				Description: "Number of requests allowed in a burst above the rate limit. Defaults to 5.",
+				Optional:    true,
+			},
+			"request_timeout": &schema.IntAttribute{
+				Description: "Timeout in seconds of each API request. Defaults to 10, 0 disables it.",
+				Optional:    true,
+			},
+			"create_timeout": &schema.IntAttribute{
+				Description: "Timeout in seconds for creating a resource, including retries. Defaults to request_timeout per request.",
+				Optional:    true,
+			},
+			"discover_timeout": &schema.IntAttribute{
+				Description: "Timeout in seconds for discovering the streams of a source, including retries. Defaults to request_timeout per request.",
+				Optional:    true,
+			},
 		},
 	}
 
@@ -71,26 +140,71 @@ func (p *Provider) Configure(req *schema.ServiceRequest) *schema.ServiceResponse
 	if err != nil {
 		return schema.ErrorResponse(err)
 	}
-	if pm.Host == "" || pm.Authorization == "" {
+
+	// Fall back to environment for the attributes not configured.
+	if pm.Host == "" {
+		pm.Host = os.Getenv("AIRBYTE_HOST")
+	}
+	if pm.Host == "" {
+		pm.Host = defaultHost
+	}
+	if pm.Authorization == "" {
+		pm.Authorization = os.Getenv("AIRBYTE_TOKEN")
+	}
+	if pm.ClientID == "" {
+		pm.ClientID = os.Getenv("AIRBYTE_CLIENT_ID")
+	}
+	if pm.ClientSecret == "" {
+		pm.ClientSecret = os.Getenv("AIRBYTE_CLIENT_SECRET")
+	}
+
+	hasClientCreds := pm.ClientID != "" && pm.ClientSecret != ""
+	if pm.Authorization == "" && !hasClientCreds {
 		return schema.ErrorResponse(fmt.Errorf(
-			"invalid host or credentials received.\n" +
+			"invalid credentials received.\n" +
 				"Provider is unable to create Airbyte API client.",
 		))
 	}
 
+	// Make API creds available for Resource type Configure methods.
+	creds := map[string]string{
+		"host":          pm.Host,
+		"authorization": pm.Authorization,
+	}
+	if hasClientCreds {
+		creds["client_id"] = pm.ClientID
+		creds["client_secret"] = pm.ClientSecret
+	}
+	if pm.MaxRetries != nil {
+		creds["max_retries"] = strconv.Itoa(*pm.MaxRetries)
+	}
+	if pm.RetryMaxWait != nil {
+		creds["retry_max_wait"] = strconv.Itoa(*pm.RetryMaxWait)
+	}
+	if pm.RequestsPerSecond != nil {
+		creds["requests_per_second"] = strconv.FormatFloat(*pm.RequestsPerSecond, 'f', -1, 64)
+	}
+	if pm.Burst != nil {
+		creds["burst"] = strconv.Itoa(*pm.Burst)
+	}
+	if pm.RequestTimeout != nil {
+		creds["request_timeout"] = strconv.Itoa(*pm.RequestTimeout)
+	}
+	if pm.CreateTimeout != nil {
+		creds["create_timeout"] = strconv.Itoa(*pm.CreateTimeout)
+	}
+	if pm.DiscoverTimeout != nil {
+		creds["discover_timeout"] = strconv.Itoa(*pm.DiscoverTimeout)
+	}
+
 	if p.Client == nil {
-		client, err := api.NewClient(pm.Host, pm.Authorization)
+		client, err := newClient(creds)
 		if err != nil {
 			return schema.ErrorResponse(err)
 		}
 		p.Client = client
 	}
 
-	// Make API creds available for Resource type Configure methods.
-	creds := map[string]string{
-		"host":          pm.Host,
-		"authorization": pm.Authorization,
-	}
 	cEnc, err := fwhelpers.Encode(creds)
 	if err != nil {
 		return schema.ErrorResponse(err)
@@ -112,3 +226,62 @@ func (p *Provider) UpdateResourceServices(resServices map[string]string) {
 		p.ResourceServices = resServices
 	}
 }
+
+// Helper function to create an API client from the creds
+// shared by the provider with the resources.
+func newClient(creds map[string]string) (*api.Client, error) {
+	client, err := api.NewClient(creds["host"], creds["authorization"])
+	if err != nil {
+		return nil, err
+	}
+	client.ClientID = creds["client_id"]
+	client.ClientSecret = creds["client_secret"]
+
+	if v, ok := creds["max_retries"]; ok {
+		maxRetries, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		client.MaxRetries = maxRetries
+	}
+	if v, ok := creds["retry_max_wait"]; ok {
+		retryMaxWait, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		client.RetryMaxWait = time.Duration(retryMaxWait) * time.Second
+	}
+
+	timeouts := map[string]*time.Duration{
+		"request_timeout":  &client.RequestTimeout,
+		"create_timeout":   &client.CreateTimeout,
+		"discover_timeout": &client.DiscoverTimeout,
+	}
+	for key, timeout := range timeouts {
+		if v, ok := creds[key]; ok {
+			seconds, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, err
+			}
+			*timeout = time.Duration(seconds) * time.Second
+		}
+	}
+
+	requestsPerSecond := api.DefaultRequestsPerSecond
+	burst := api.DefaultBurst
+	if v, ok := creds["requests_per_second"]; ok {
+		requestsPerSecond, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if v, ok := creds["burst"]; ok {
+		burst, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	client.SetRateLimit(requestsPerSecond, burst)
+
+	return client, nil
+}
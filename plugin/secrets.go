@@ -0,0 +1,19 @@
+package plugin
+
+import "strings"
+
+// Helper function to reconcile a secret returned by Airbyte API with
+// the value known in state. Secrets are masked with asterisks or left
+// out of the responses, in which case the state value is retained.
+func reconcileSecret(remote string, current string) string {
+	if strings.Trim(remote, "*") == "" {
+		return current
+	}
+	return remote
+}
+
+// Helper function to check whether a value returned by Airbyte API
+// is a masked secret.
+func isMaskedSecret(value string) bool {
+	return value != "" && strings.Trim(value, "*") == ""
+}
@@ -0,0 +1,466 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zipstack/pct-plugin-framework/fwhelpers"
+	"github.com/zipstack/pct-plugin-framework/schema"
+
+	"github.com/zipstack/pct-provider-airbyte-cloud/api"
+)
+
+// Resource implementation for sources of any type, including the
+// ones not in the registry, configured with free-form JSON.
+type sourceResource struct {
+	Client *api.Client
+}
+
+type sourceResourceModel struct {
+	Name          string `pctsdk:"name"`
+	SourceId      string `pctsdk:"source_id"`
+	WorkspaceId   string `pctsdk:"workspace_id"`
+	DefinitionId  string `pctsdk:"definition_id"`
+	SourceType    string `pctsdk:"source_type"`
+	Configuration string `pctsdk:"configuration"`
+	Secrets       string `pctsdk:"secrets"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ schema.ResourceService = &sourceResource{}
+)
+
+// Helper function to return a resource service instance.
+func NewSourceResource() schema.ResourceService {
+	return &sourceResource{}
+}
+
+// Metadata returns the resource type name.
+// It is always provider name + "_" + resource type name.
+func (r *sourceResource) Metadata(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{
+		TypeName: req.TypeName + "_source",
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sourceResource) Configure(req *schema.ServiceRequest) *schema.ServiceResponse {
+	if req.ResourceData == "" {
+		return schema.ErrorResponse(fmt.Errorf("no data provided to configure resource"))
+	}
+
+	var creds map[string]string
+	err := fwhelpers.Decode(req.ResourceData, &creds)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	client, err := newClient(creds)
+	if err != nil {
+		return schema.ErrorResponse(fmt.Errorf("malformed data provided to configure resource"))
+	}
+
+	r.Client = client
+
+	return &schema.ServiceResponse{}
+}
+
+// Schema defines the schema for the resource.
+func (r *sourceResource) Schema() *schema.ServiceResponse {
+	s := &schema.Schema{
+		Description: "Source resource for Airbyte, of any type",
+		Attributes: map[string]schema.Attribute{
+			"name": &schema.StringAttribute{
+				Description: "Name",
+				Required:    true,
+			},
+			"source_id": &schema.StringAttribute{
+				Description: "Source ID",
+				Required:    false,
+				Computed:    true,
+			},
+			"workspace_id": &schema.StringAttribute{
+				Description: "Workspace ID",
+				Required:    true,
+			},
+			"definition_id": &schema.StringAttribute{
+				Description: "Source Definition ID, for connectors without a source type",
+				Required:    true,
+				Optional:    true,
+			},
+			"source_type": &schema.StringAttribute{
+				Description: "Source Type, e.g. salesforce",
+				Required:    true,
+				Optional:    true,
+			},
+			"configuration": &schema.StringAttribute{
+				Description: "JSON encoded connection configuration, as described by the connector specification",
+				Required:    true,
+			},
+			"secrets": &schema.StringAttribute{
+				Description: "JSON encoded secrets, merged into the connection configuration. Secrets masked by Airbyte are left out of the configuration on import and are to be set here",
+				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+
+	sEnc, err := fwhelpers.Encode(s)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		SchemaContents: sEnc,
+	}
+}
+
+// Create a new resource
+func (r *sourceResource) Create(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan sourceResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Generate API request body from plan
+	body, err := sourceFromModel(plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Create new source
+	source, err := r.Client.CreateSource(baseContext, body)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Update resource state with response body and planned configuration
+	state := plan
+	state.Name = source.Name
+	state.SourceId = source.SourceId
+	state.WorkspaceId = source.WorkspaceId
+
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          state.SourceId,
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
+
+// Read resource information
+func (r *sourceResource) Read(req *schema.ServiceRequest) *schema.ServiceResponse {
+	var state sourceResourceModel
+
+	// Get current state, which is empty when importing by ID
+	importing := req.StateContents == ""
+	if !importing {
+		err := fwhelpers.UnpackModel(req.StateContents, &state)
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+	}
+
+	res := schema.ServiceResponse{}
+
+	if req.StateID != "" {
+		// Query using existing previous state.
+		source, err := r.Client.ReadSource(baseContext, req.StateID)
+		if api.IsNotFound(err) {
+			if importing {
+				return schema.ErrorResponse(fmt.Errorf("source %s not found", req.StateID))
+			}
+			// Removed outside of PCT, empty StateID plans a re-create.
+			return &schema.ServiceResponse{
+				StateContents: req.StateContents,
+			}
+		}
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+
+		// Update state with refreshed value
+		state.Name = source.Name
+		state.SourceId = source.SourceId
+		state.WorkspaceId = source.WorkspaceId
+		if importing || (state.SourceType != "" && source.SourceType != "") {
+			state.SourceType = source.SourceType
+		}
+		if state.DefinitionId != "" && source.DefinitionId != "" {
+			state.DefinitionId = source.DefinitionId
+		}
+
+		err = refreshSourceConfiguration(&state, source.Configuration, importing)
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+
+		res.StateID = state.SourceId
+	} else {
+		// No previous state exists.
+		res.StateID = ""
+	}
+
+	// Set refreshed state
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	res.StateContents = stateEnc
+
+	return &res
+}
+
+func (r *sourceResource) Update(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan sourceResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Generate API request body from plan, the definition
+	// of an existing source being fixed
+	body, err := sourceFromModel(plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	body.SourceId = req.PlanID
+	body.DefinitionId = ""
+
+	// Update existing source
+	source, err := r.Client.UpdateSource(baseContext, body)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Update state with refreshed value and planned configuration
+	state := plan
+	state.Name = source.Name
+	state.SourceId = source.SourceId
+	state.WorkspaceId = source.WorkspaceId
+
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          state.SourceId,
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
+
+// Delete deletes the resource and removes the state on success.
+func (r *sourceResource) Delete(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Delete existing source
+	err := r.Client.DeleteSource(baseContext, req.StateID)
+	if err != nil && !api.IsNotFound(err) {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{}
+}
+
+// Helper function to generate the API request body from the plan,
+// the secrets being merged into the configuration sent verbatim.
+func sourceFromModel(plan sourceResourceModel) (api.Source, error) {
+	if plan.DefinitionId == "" && plan.SourceType == "" {
+		return api.Source{}, fmt.Errorf("definition_id or source_type should be set")
+	}
+
+	config, err := decodeJSONObject("configuration", plan.Configuration)
+	if err != nil {
+		return api.Source{}, err
+	}
+	secrets, err := decodeJSONObject("secrets", plan.Secrets)
+	if err != nil {
+		return api.Source{}, err
+	}
+	mergeJSONObjects(config, secrets)
+
+	// Masked values would replace the credentials of the source.
+	masked := maskedJSONKey(config, "configuration.")
+	if masked != "" {
+		return api.Source{}, fmt.Errorf("%s: masked secret value, set it in secrets instead", masked)
+	}
+
+	if _, ok := config["sourceType"]; !ok && plan.SourceType != "" {
+		config["sourceType"] = plan.SourceType
+	}
+
+	return api.Source{
+		Name:          plan.Name,
+		WorkspaceId:   plan.WorkspaceId,
+		DefinitionId:  plan.DefinitionId,
+		Configuration: config,
+	}, nil
+}
+
+// Helper function to refresh the configuration and secrets of the
+// state from the Airbyte configuration. Only the keys configured are
+// refreshed, the ones added by Airbyte such as defaults being left
+// out, and secrets masked in the configuration are retained. The
+// JSON is rewritten only when it differs once normalised, so that
+// the formatting of the configured JSON does not cause changes.
+func refreshSourceConfiguration(state *sourceResourceModel, remote map[string]any, importing bool) error {
+	if importing {
+		// Masked secrets are left to be set in secrets.
+		config := withoutMaskedSecrets(remote)
+		delete(config, "sourceType")
+		configEnc, err := json.Marshal(config)
+		if err != nil {
+			return err
+		}
+		state.Configuration = string(configEnc)
+		return nil
+	}
+
+	current, err := decodeJSONObject("configuration", state.Configuration)
+	if err != nil {
+		return err
+	}
+	state.Configuration, err = refreshJSON(state.Configuration, refreshJSONObject(remote, current))
+	if err != nil {
+		return err
+	}
+
+	if state.Secrets == "" {
+		return nil
+	}
+	currentSecrets, err := decodeJSONObject("secrets", state.Secrets)
+	if err != nil {
+		return err
+	}
+	state.Secrets, err = refreshJSON(state.Secrets, refreshJSONObject(remote, currentSecrets))
+	return err
+}
+
+// Helper function to decode a JSON encoded object, an empty
+// string standing for an empty object.
+func decodeJSONObject(attr string, s string) (map[string]any, error) {
+	obj := map[string]any{}
+	if s == "" {
+		return obj, nil
+	}
+	err := json.Unmarshal([]byte(s), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid JSON object: %w", attr, err)
+	}
+	return obj, nil
+}
+
+// Helper function to merge the src object into dst, recursively
+// for the nested objects present in both.
+func mergeJSONObjects(dst map[string]any, src map[string]any) {
+	for key, value := range src {
+		srcObj, srcOk := value.(map[string]any)
+		dstObj, dstOk := dst[key].(map[string]any)
+		if srcOk && dstOk {
+			mergeJSONObjects(dstObj, srcObj)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// Helper function to copy an object without its masked secrets,
+// recursively for the nested objects.
+func withoutMaskedSecrets(obj map[string]any) map[string]any {
+	copied := map[string]any{}
+	for key, value := range obj {
+		switch v := value.(type) {
+		case map[string]any:
+			copied[key] = withoutMaskedSecrets(v)
+		case string:
+			if !isMaskedSecret(v) {
+				copied[key] = v
+			}
+		default:
+			copied[key] = value
+		}
+	}
+	return copied
+}
+
+// Helper function to find the path of a masked secret in an object,
+// returning an empty string if there is none.
+func maskedJSONKey(obj map[string]any, path string) string {
+	keys := []string{}
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		switch v := obj[key].(type) {
+		case map[string]any:
+			masked := maskedJSONKey(v, path+key+".")
+			if masked != "" {
+				return masked
+			}
+		case string:
+			if isMaskedSecret(v) {
+				return path + key
+			}
+		}
+	}
+	return ""
+}
+
+// Helper function to get the remote values of the current keys,
+// retaining the current strings when they are masked remotely.
+func refreshJSONObject(remote map[string]any, current map[string]any) map[string]any {
+	obj := map[string]any{}
+	for key, currentValue := range current {
+		remoteValue, ok := remote[key]
+		if !ok {
+			continue
+		}
+		switch v := remoteValue.(type) {
+		case map[string]any:
+			if currentObj, ok := currentValue.(map[string]any); ok {
+				obj[key] = refreshJSONObject(v, currentObj)
+				continue
+			}
+		case string:
+			// Only asterisks are a masked secret, empty strings being
+			// values cleared outside of PCT.
+			if currentString, ok := currentValue.(string); ok && isMaskedSecret(v) {
+				obj[key] = currentString
+				continue
+			}
+		}
+		obj[key] = remoteValue
+	}
+	return obj
+}
+
+// Helper function to encode the refreshed object, keeping the
+// current JSON when both are equal once normalised.
+func refreshJSON(current string, refreshed map[string]any) (string, error) {
+	refreshedEnc, err := json.Marshal(refreshed)
+	if err != nil {
+		return "", err
+	}
+
+	var currentValue any
+	if json.Unmarshal([]byte(current), &currentValue) == nil {
+		currentEnc, err := json.Marshal(currentValue)
+		if err == nil && string(currentEnc) == string(refreshedEnc) {
+			return current, nil
+		}
+	}
+	return string(refreshedEnc), nil
+}
@@ -0,0 +1,236 @@
+package plugin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zipstack/pct-plugin-framework/fwhelpers"
+	"github.com/zipstack/pct-plugin-framework/schema"
+
+	"github.com/zipstack/pct-provider-airbyte-cloud/api"
+)
+
+// Read-only resource implementation for stream discovery.
+type sourceStreamsResource struct {
+	Client *api.Client
+}
+
+type sourceStreamsResourceModel struct {
+	SourceID      string                  `pctsdk:"source_id"`
+	DestinationID string                  `pctsdk:"destination_id"`
+	IgnoreCache   bool                    `pctsdk:"ignore_cache"`
+	Streams       []sourceStreamPropModel `pctsdk:"streams"`
+}
+
+type sourceStreamPropModel struct {
+	StreamName               string     `pctsdk:"stream_name"`
+	SyncModes                []string   `pctsdk:"sync_modes"`
+	DefaultCursorField       []string   `pctsdk:"default_cursor_field"`
+	SourceDefinedCursorField bool       `pctsdk:"source_defined_cursor_field"`
+	SourceDefinedPrimaryKey  [][]string `pctsdk:"source_defined_primary_key"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ schema.ResourceService = &sourceStreamsResource{}
+)
+
+// Helper function to return a resource service instance.
+func NewSourceStreamsResource() schema.ResourceService {
+	return &sourceStreamsResource{}
+}
+
+// Metadata returns the resource type name.
+// It is always provider name + "_" + resource type name.
+func (r *sourceStreamsResource) Metadata(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{
+		TypeName: req.TypeName + "_source_streams",
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sourceStreamsResource) Configure(req *schema.ServiceRequest) *schema.ServiceResponse {
+	if req.ResourceData == "" {
+		return schema.ErrorResponse(fmt.Errorf("no data provided to configure resource"))
+	}
+
+	var creds map[string]string
+	err := fwhelpers.Decode(req.ResourceData, &creds)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	client, err := newClient(creds)
+	if err != nil {
+		return schema.ErrorResponse(fmt.Errorf("malformed data provided to configure resource"))
+	}
+
+	r.Client = client
+
+	return &schema.ServiceResponse{}
+}
+
+// Schema defines the schema for the resource.
+func (r *sourceStreamsResource) Schema() *schema.ServiceResponse {
+	s := &schema.Schema{
+		Description: "Read-only stream discovery for an Airbyte source",
+		Attributes: map[string]schema.Attribute{
+			"source_id": &schema.StringAttribute{
+				Description: "Source ID",
+				Required:    true,
+			},
+			"destination_id": &schema.StringAttribute{
+				Description: "Destination ID. Sync modes are narrowed to the ones supported by the destination.",
+				Required:    true,
+			},
+			"ignore_cache": &schema.BoolAttribute{
+				Description: "Re-run discovery instead of using the cached source schema",
+				Required:    true,
+				Optional:    true,
+			},
+			"streams": &schema.ListAttribute{
+				Description: "Available streams",
+				Computed:    true,
+				NestedAttribute: &schema.MapAttribute{
+					Description: "Stream",
+					Computed:    true,
+					Attributes: map[string]schema.Attribute{
+						"stream_name": &schema.StringAttribute{
+							Description: "Stream name",
+							Computed:    true,
+						},
+						"sync_modes": &schema.ListAttribute{
+							Description: "Supported sync modes",
+							Computed:    true,
+							NestedAttribute: &schema.StringAttribute{
+								Description: "Sync mode",
+								Computed:    true,
+							},
+						},
+						"default_cursor_field": &schema.ListAttribute{
+							Description: "Path to the default cursor field",
+							Computed:    true,
+							NestedAttribute: &schema.StringAttribute{
+								Description: "Path segment",
+								Computed:    true,
+							},
+						},
+						"source_defined_cursor_field": &schema.BoolAttribute{
+							Description: "Whether the cursor field is defined by the source",
+							Computed:    true,
+						},
+						"source_defined_primary_key": &schema.ListAttribute{
+							Description: "Paths to the source defined primary key fields",
+							Computed:    true,
+							NestedAttribute: &schema.ListAttribute{
+								Description: "Path to a primary key field",
+								Computed:    true,
+								NestedAttribute: &schema.StringAttribute{
+									Description: "Path segment",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	sEnc, err := fwhelpers.Encode(s)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		SchemaContents: sEnc,
+	}
+}
+
+// Create discovers the streams for the given source.
+func (r *sourceStreamsResource) Create(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan sourceStreamsResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return r.discover(plan)
+}
+
+// Read refreshes the discovered streams.
+func (r *sourceStreamsResource) Read(req *schema.ServiceRequest) *schema.ServiceResponse {
+	var state sourceStreamsResourceModel
+
+	// Get current state
+	err := fwhelpers.UnpackModel(req.StateContents, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	if req.StateID == "" {
+		// No previous state exists.
+		return &schema.ServiceResponse{
+			StateContents: req.StateContents,
+		}
+	}
+
+	return r.discover(state)
+}
+
+// Update discovers the streams again with the new inputs.
+func (r *sourceStreamsResource) Update(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan sourceStreamsResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return r.discover(plan)
+}
+
+// Delete only removes the state as nothing exists remotely.
+func (r *sourceStreamsResource) Delete(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{}
+}
+
+func (r *sourceStreamsResource) discover(model sourceStreamsResourceModel) *schema.ServiceResponse {
+	body := api.DiscoverSourceSchemaCatalog{}
+	body.SourceID = model.SourceID
+	body.DestinationID = model.DestinationID
+	body.DisableCache = model.IgnoreCache
+
+	streams, err := r.Client.DiscoverSourceStreams(baseContext, body)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state := sourceStreamsResourceModel{}
+	state.SourceID = model.SourceID
+	state.DestinationID = model.DestinationID
+	state.IgnoreCache = model.IgnoreCache
+
+	for _, stream := range streams {
+		state.Streams = append(state.Streams, sourceStreamPropModel{
+			StreamName:               stream.StreamName,
+			SyncModes:                stream.SyncModes,
+			DefaultCursorField:       stream.DefaultCursorField,
+			SourceDefinedCursorField: stream.SourceDefinedCursorField,
+			SourceDefinedPrimaryKey:  stream.SourceDefinedPrimaryKey,
+		})
+	}
+
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          state.SourceID,
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
@@ -0,0 +1,178 @@
+package plugin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zipstack/pct-plugin-framework/fwhelpers"
+	"github.com/zipstack/pct-plugin-framework/schema"
+
+	"github.com/zipstack/pct-provider-airbyte-cloud/api"
+)
+
+// Read-only resource implementation for workspace lookup by name.
+type workspaceLookupResource struct {
+	Client *api.Client
+}
+
+type workspaceLookupResourceModel struct {
+	Name          string `pctsdk:"name"`
+	WorkspaceId   string `pctsdk:"workspace_id"`
+	DataResidency string `pctsdk:"data_residency"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ schema.ResourceService = &workspaceLookupResource{}
+)
+
+// Helper function to return a resource service instance.
+func NewWorkspaceLookupResource() schema.ResourceService {
+	return &workspaceLookupResource{}
+}
+
+// Metadata returns the resource type name.
+// It is always provider name + "_" + resource type name.
+func (r *workspaceLookupResource) Metadata(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{
+		TypeName: req.TypeName + "_workspace_lookup",
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workspaceLookupResource) Configure(req *schema.ServiceRequest) *schema.ServiceResponse {
+	if req.ResourceData == "" {
+		return schema.ErrorResponse(fmt.Errorf("no data provided to configure resource"))
+	}
+
+	var creds map[string]string
+	err := fwhelpers.Decode(req.ResourceData, &creds)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	client, err := newClient(creds)
+	if err != nil {
+		return schema.ErrorResponse(fmt.Errorf("malformed data provided to configure resource"))
+	}
+
+	r.Client = client
+
+	return &schema.ServiceResponse{}
+}
+
+// Schema defines the schema for the resource.
+func (r *workspaceLookupResource) Schema() *schema.ServiceResponse {
+	s := &schema.Schema{
+		Description: "Read-only lookup of an Airbyte workspace by name",
+		Attributes: map[string]schema.Attribute{
+			"name": &schema.StringAttribute{
+				Description: "Exact name of the workspace",
+				Required:    true,
+			},
+			"workspace_id": &schema.StringAttribute{
+				Description: "Workspace ID",
+				Computed:    true,
+			},
+			"data_residency": &schema.StringAttribute{
+				Description: "Data Residency",
+				Computed:    true,
+			},
+		},
+	}
+
+	sEnc, err := fwhelpers.Encode(s)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		SchemaContents: sEnc,
+	}
+}
+
+// Create looks up the workspace for the given name.
+func (r *workspaceLookupResource) Create(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan workspaceLookupResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return r.lookup(plan.Name)
+}
+
+// Read looks up the workspace again to refresh its attributes.
+func (r *workspaceLookupResource) Read(req *schema.ServiceRequest) *schema.ServiceResponse {
+	var state workspaceLookupResourceModel
+
+	// Get current state
+	err := fwhelpers.UnpackModel(req.StateContents, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	if req.StateID == "" {
+		// No previous state exists.
+		return &schema.ServiceResponse{
+			StateContents: req.StateContents,
+		}
+	}
+
+	return r.lookup(state.Name)
+}
+
+// Update looks up the workspace for the changed name.
+func (r *workspaceLookupResource) Update(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Retrieve values from plan
+	var plan workspaceLookupResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return r.lookup(plan.Name)
+}
+
+// Delete only removes the state as the workspace is not managed here.
+func (r *workspaceLookupResource) Delete(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{}
+}
+
+func (r *workspaceLookupResource) lookup(name string) *schema.ServiceResponse {
+	workspaces, err := r.Client.ListWorkspaces(baseContext)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	matches := []api.Workspace{}
+	for _, workspace := range workspaces {
+		if workspace.Name == name {
+			matches = append(matches, workspace)
+		}
+	}
+	if len(matches) == 0 {
+		return schema.ErrorResponse(fmt.Errorf("no workspace found with name %q", name))
+	}
+	if len(matches) > 1 {
+		return schema.ErrorResponse(fmt.Errorf("%d workspaces found with name %q", len(matches), name))
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state := workspaceLookupResourceModel{}
+	state.Name = matches[0].Name
+	state.WorkspaceId = matches[0].WorkspaceId
+	state.DataResidency = matches[0].DataResidency
+
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          state.WorkspaceId,
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
@@ -0,0 +1,249 @@
+package plugin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zipstack/pct-plugin-framework/fwhelpers"
+	"github.com/zipstack/pct-plugin-framework/schema"
+
+	"github.com/zipstack/pct-provider-airbyte-cloud/api"
+)
+
+// Resource implementation.
+type workspaceResource struct {
+	Client *api.Client
+}
+
+type workspaceResourceModel struct {
+	Name          string `pctsdk:"name"`
+	WorkspaceId   string `pctsdk:"workspace_id"`
+	DataResidency string `pctsdk:"data_residency"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ schema.ResourceService = &workspaceResource{}
+)
+
+// Helper function to return a resource service instance.
+func NewWorkspaceResource() schema.ResourceService {
+	return &workspaceResource{}
+}
+
+// Metadata returns the resource type name.
+// It is always provider name + "_" + resource type name.
+func (r *workspaceResource) Metadata(req *schema.ServiceRequest) *schema.ServiceResponse {
+	return &schema.ServiceResponse{
+		TypeName: req.TypeName + "_workspace",
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workspaceResource) Configure(req *schema.ServiceRequest) *schema.ServiceResponse {
+	if req.ResourceData == "" {
+		return schema.ErrorResponse(fmt.Errorf("no data provided to configure resource"))
+	}
+
+	var creds map[string]string
+	err := fwhelpers.Decode(req.ResourceData, &creds)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	client, err := newClient(creds)
+	if err != nil {
+		return schema.ErrorResponse(fmt.Errorf("malformed data provided to configure resource"))
+	}
+
+	r.Client = client
+
+	return &schema.ServiceResponse{}
+}
+
+// Schema defines the schema for the resource.
+func (r *workspaceResource) Schema() *schema.ServiceResponse {
+	s := &schema.Schema{
+		Description: "Workspace resource for Airbyte",
+		Attributes: map[string]schema.Attribute{
+			"name": &schema.StringAttribute{
+				Description: "Name",
+				Required:    true,
+			},
+			"workspace_id": &schema.StringAttribute{
+				Description: "Workspace ID",
+				Required:    false,
+				Computed:    true,
+			},
+			"data_residency": &schema.StringAttribute{
+				Description: "Data Residency. One of auto, us or eu",
+				Required:    true,
+				Optional:    true,
+			},
+		},
+	}
+
+	sEnc, err := fwhelpers.Encode(s)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		SchemaContents: sEnc,
+	}
+}
+
+// Create a new resource
+func (r *workspaceResource) Create(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// logger := fwhelpers.GetLogger()
+
+	// Retrieve values from plan
+	var plan workspaceResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Generate API request body from plan
+	body := api.Workspace{}
+	body.Name = plan.Name
+	body.DataResidency = plan.DataResidency
+
+	// Create new workspace
+	workspace, err := r.Client.CreateWorkspace(baseContext, body)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Update resource state with response body
+	state := workspaceResourceModel{}
+	state.Name = workspace.Name
+	state.WorkspaceId = workspace.WorkspaceId
+	state.DataResidency = workspaceDataResidency(workspace.DataResidency, plan.DataResidency)
+
+	// Set refreshed state
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          state.WorkspaceId,
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
+
+// Read resource information
+func (r *workspaceResource) Read(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// logger := fwhelpers.GetLogger()
+
+	var state workspaceResourceModel
+
+	// Get current state, which is empty when importing by ID
+	if req.StateContents != "" {
+		err := fwhelpers.UnpackModel(req.StateContents, &state)
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+	}
+
+	res := schema.ServiceResponse{}
+
+	if req.StateID != "" {
+		// Query using existing previous state.
+		workspace, err := r.Client.ReadWorkspace(baseContext, req.StateID)
+		if api.IsNotFound(err) {
+			if req.StateContents == "" {
+				return schema.ErrorResponse(fmt.Errorf("workspace %s not found", req.StateID))
+			}
+			// Removed outside of PCT, empty StateID plans a re-create.
+			return &schema.ServiceResponse{
+				StateContents: req.StateContents,
+			}
+		}
+		if err != nil {
+			return schema.ErrorResponse(err)
+		}
+
+		// Update state with refreshed value
+		state.Name = workspace.Name
+		state.WorkspaceId = workspace.WorkspaceId
+		state.DataResidency = workspaceDataResidency(workspace.DataResidency, state.DataResidency)
+
+		res.StateID = state.WorkspaceId
+	} else {
+		// No previous state exists.
+		res.StateID = ""
+	}
+
+	// Set refreshed state
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	res.StateContents = stateEnc
+
+	return &res
+}
+
+func (r *workspaceResource) Update(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// logger := fwhelpers.GetLogger()
+
+	// Retrieve values from plan
+	var plan workspaceResourceModel
+	err := fwhelpers.UnpackModel(req.PlanContents, &plan)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Generate API request body from plan
+	body := api.Workspace{}
+	body.Name = plan.Name
+	body.WorkspaceId = req.PlanID
+	body.DataResidency = plan.DataResidency
+
+	// Update existing workspace
+	workspace, err := r.Client.UpdateWorkspace(baseContext, body)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	// Update state with refreshed value
+	state := workspaceResourceModel{}
+	state.Name = workspace.Name
+	state.WorkspaceId = workspace.WorkspaceId
+	state.DataResidency = workspaceDataResidency(workspace.DataResidency, plan.DataResidency)
+
+	// Set refreshed state
+	stateEnc, err := fwhelpers.PackModel(nil, &state)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{
+		StateID:          state.WorkspaceId,
+		StateContents:    stateEnc,
+		StateLastUpdated: time.Now().Format(time.RFC850),
+	}
+}
+
+// Delete deletes the resource and removes the state on success.
+func (r *workspaceResource) Delete(req *schema.ServiceRequest) *schema.ServiceResponse {
+	// Delete existing workspace
+	err := r.Client.DeleteWorkspace(baseContext, req.StateID)
+	if err != nil && !api.IsNotFound(err) {
+		return schema.ErrorResponse(err)
+	}
+
+	return &schema.ServiceResponse{}
+}
+
+// Airbyte applies auto when data residency is left unset, which is
+// kept unset in state as configured.
+func workspaceDataResidency(remote string, configured string) string {
+	if configured == "" && remote == "auto" {
+		return ""
+	}
+	return remote
+}